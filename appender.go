@@ -0,0 +1,66 @@
+package pduckdb
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+)
+
+// Appender bulk loads rows into a table through DuckDB's appender,
+// which is much faster than executing INSERT statements row by row.
+// Rows are buffered and written to the table on Flush and Close.
+type Appender struct {
+	appender *duckdb.Appender
+}
+
+// NewAppender creates an appender for schema.table on a driver connection.
+// An empty schema selects the default schema.
+// The driver connection is obtained from a *sql.Conn with Raw, and the
+// appender must be closed before Raw returns:
+//
+//	err := conn.Raw(func(driverConn any) error {
+//		appender, err := pduckdb.NewAppender(driverConn, "", "events")
+//		if err != nil {
+//			return err
+//		}
+//		for _, e := range events {
+//			if err := appender.AppendRow(e.ID, e.Name, e.Time); err != nil {
+//				_ = appender.Close()
+//				return err
+//			}
+//		}
+//		return appender.Close()
+//	})
+func NewAppender(driverConn any, schema, table string) (*Appender, error) {
+	conn, ok := driverConn.(*Conn)
+	if !ok {
+		return nil, errors.Errorf("appender requires a pduckdb connection, got %T", driverConn)
+	}
+
+	conn.bufferRows()
+	appender, err := conn.conn.NewAppender(schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Appender{
+		appender: appender,
+	}, nil
+}
+
+// AppendRow appends one row with a value for every column of the table.
+// Values are converted to the column types like bound parameters; nil appends NULL.
+// A value that cannot be converted is reported before any value of the row is appended.
+func (a *Appender) AppendRow(values ...any) error {
+	return a.appender.AppendRow(values)
+}
+
+// Flush writes the buffered rows to the table
+func (a *Appender) Flush() error {
+	return a.appender.Flush()
+}
+
+// Close flushes the buffered rows and releases the appender
+func (a *Appender) Close() error {
+	return a.appender.Close()
+}
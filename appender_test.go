@@ -0,0 +1,106 @@
+package pduckdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAppenderRequiresDriverConn(t *testing.T) {
+	_, err := NewAppender("not a connection", "", "events")
+	assert.Error(t, err)
+}
+
+func TestAppender(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	ctx := context.Background()
+	conn, err := sqlDB.Conn(ctx)
+	assert.NoError(t, err, "Error getting connection")
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	_, err = conn.ExecContext(ctx, `CREATE TABLE events (
+		id BIGINT PRIMARY KEY,
+		name VARCHAR,
+		happened TIMESTAMP,
+		day DATE,
+		score DOUBLE,
+		amount DECIMAL(10, 2),
+		payload BLOB
+	)`)
+	assert.NoError(t, err, "Error creating table")
+
+	ts := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	const rowCount = 10000
+
+	err = conn.Raw(func(driverConn any) error {
+		appender, err := NewAppender(driverConn, "", "events")
+		if err != nil {
+			return err
+		}
+		for i := 0; i < rowCount; i++ {
+			var name any = "event"
+			if i%2 == 0 {
+				name = nil
+			}
+			err := appender.AppendRow(i, name, ts, ts, float64(i)/2, "12.34", []byte{0x00, 0xFF})
+			if err != nil {
+				_ = appender.Close()
+				return err
+			}
+		}
+		return appender.Close()
+	})
+	assert.NoError(t, err, "Error appending rows")
+
+	var count, names int64
+	var maxScore float64
+	err = conn.QueryRowContext(ctx, "SELECT count(*), count(name), max(score) FROM events").Scan(&count, &names, &maxScore)
+	assert.NoError(t, err, "Error querying appended rows")
+	assert.Equal(t, int64(rowCount), count)
+	assert.Equal(t, int64(rowCount/2), names)
+	assert.Equal(t, float64(rowCount-1)/2, maxScore)
+
+	var happened time.Time
+	var amount string
+	err = conn.QueryRowContext(ctx, "SELECT happened, amount::VARCHAR FROM events WHERE id = 1").Scan(&happened, &amount)
+	assert.NoError(t, err, "Error querying appended row")
+	assert.True(t, ts.Equal(happened), "Expected %v, got %v", ts, happened)
+	assert.Equal(t, "12.34", amount)
+
+	// Constraint violations are reported when the rows are flushed
+	err = conn.Raw(func(driverConn any) error {
+		appender, err := NewAppender(driverConn, "main", "events")
+		if err != nil {
+			return err
+		}
+		if err := appender.AppendRow(1, "duplicate", ts, ts, 0.0, "0", nil); err != nil {
+			_ = appender.Close()
+			return err
+		}
+		return appender.Close()
+	})
+	var duckErr *Error
+	assert.True(t, errors.As(err, &duckErr), "Expected *Error, got %v", err)
+
+	// Unknown tables fail when the appender is created
+	err = conn.Raw(func(driverConn any) error {
+		_, err := NewAppender(driverConn, "", "missing")
+		return err
+	})
+	assert.Error(t, err)
+}
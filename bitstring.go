@@ -0,0 +1,25 @@
+package pduckdb
+
+import (
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+)
+
+// BitString is a DuckDB BIT value of any length. BIT columns scan into a BitString,
+// which keeps the exact number of bits and implements sql.Scanner and driver.Valuer.
+// BIT parameters accept a BitString or a string of 0s and 1s.
+type BitString = duckdb.BitString
+
+// NewBitString returns a BitString of length bits, all 0
+func NewBitString(length int) BitString {
+	return duckdb.NewBitString(length)
+}
+
+// BitStringFromBytes returns a BitString of the bits of b, most significant bit first
+func BitStringFromBytes(b []byte) BitString {
+	return duckdb.BitStringFromBytes(b)
+}
+
+// ParseBitString parses a string of 0s and 1s like "10110"
+func ParseBitString(s string) (BitString, error) {
+	return duckdb.ParseBitString(s)
+}
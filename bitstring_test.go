@@ -0,0 +1,61 @@
+package pduckdb
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBitString(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec("CREATE TABLE permissions (role VARCHAR, mask BIT)")
+	assert.NoError(t, err)
+
+	// BIT values are bound from BitString and text, keeping their exact length
+	admin := NewBitString(10)
+	admin.Set(0, true)
+	admin.Set(9, true)
+	_, err = sqlDB.Exec("INSERT INTO permissions VALUES ('admin', ?), ('guest', ?), ('raw', ?)",
+		admin, "101", BitStringFromBytes([]byte{0xA5}))
+	assert.NoError(t, err)
+
+	var mask BitString
+	err = sqlDB.QueryRow("SELECT mask FROM permissions WHERE role = 'admin'").Scan(&mask)
+	assert.NoError(t, err)
+	assert.Equal(t, "1000000001", mask.String())
+	assert.Equal(t, 10, mask.Len())
+	assert.Equal(t, 2, mask.Count())
+	assert.True(t, mask.Test(9))
+
+	err = sqlDB.QueryRow("SELECT mask FROM permissions WHERE role = 'guest'").Scan(&mask)
+	assert.NoError(t, err)
+	assert.Equal(t, "101", mask.String())
+
+	var count int
+	err = sqlDB.QueryRow("SELECT bit_count(mask) FROM permissions WHERE mask = ?", BitStringFromBytes([]byte{0xA5})).Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, count)
+
+	_, err = sqlDB.Exec("INSERT INTO permissions VALUES ('bad', ?)", "102")
+	assert.ErrorContains(t, err, "invalid BIT")
+
+	rows, err := sqlDB.Query("SELECT mask FROM permissions")
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, rows.Close())
+	}()
+	types, err := rows.ColumnTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, reflect.TypeOf(BitString{}), types[0].ScanType())
+}
@@ -0,0 +1,106 @@
+package pduckdb
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AccessMode controls whether a database is opened for writing
+type AccessMode string
+
+// Access modes of a database
+const (
+	// AccessModeAutomatic opens the database for reading and writing,
+	// unless the file is read-only
+	AccessModeAutomatic AccessMode = "automatic"
+	// AccessModeReadOnly opens the database for reading only.
+	// Several processes can open the same file read-only.
+	AccessModeReadOnly AccessMode = "read_only"
+	// AccessModeReadWrite opens the database for reading and writing
+	AccessModeReadWrite AccessMode = "read_write"
+)
+
+// Config holds the options a database is opened with.
+// Zero values keep DuckDB's defaults.
+type Config struct {
+	// Threads is the number of threads used to execute queries
+	Threads int
+	// MemoryLimit is the maximum memory of the database, like "4GB"
+	MemoryLimit string
+	// AccessMode controls whether the database is opened for writing
+	AccessMode AccessMode
+	// TempDirectory is the directory used to spill data that does not fit in memory
+	TempDirectory string
+	// MaxTempDirectorySize is the maximum size of TempDirectory, like "100GB"
+	MaxTempDirectorySize string
+	// DefaultOrder is the order of ORDER BY without ASC or DESC, "asc" or "desc"
+	DefaultOrder string
+	// TimeZone is the session time zone, which TIMESTAMP WITH TIME ZONE values are
+	// returned in. By default, the TimeZone setting of DuckDB is used.
+	TimeZone *time.Location
+	// Options are other DuckDB configuration options by name.
+	// See https://duckdb.org/docs/configuration/overview for the available options.
+	Options map[string]string
+}
+
+// options returns all configuration options by their DuckDB name
+func (c Config) options() map[string]string {
+	options := make(map[string]string, len(c.Options)+7)
+	for name, value := range c.Options {
+		options[name] = value
+	}
+
+	if c.Threads > 0 {
+		options["threads"] = strconv.Itoa(c.Threads)
+	}
+	if c.MemoryLimit != "" {
+		options["memory_limit"] = c.MemoryLimit
+	}
+	if c.AccessMode != "" {
+		options["access_mode"] = string(c.AccessMode)
+	}
+	if c.TempDirectory != "" {
+		options["temp_directory"] = c.TempDirectory
+	}
+	if c.MaxTempDirectorySize != "" {
+		options["max_temp_directory_size"] = c.MaxTempDirectorySize
+	}
+	if c.DefaultOrder != "" {
+		options["default_order"] = c.DefaultOrder
+	}
+	if c.TimeZone != nil && c.TimeZone != time.Local {
+		// The local time zone has no name DuckDB knows
+		options["timezone"] = c.TimeZone.String()
+	}
+
+	return options
+}
+
+// ParseDSN splits a DSN like "file.db?access_mode=read_only&threads=4" into the
+// database path and its configuration. Every query parameter is passed to DuckDB
+// as a configuration option, so all of DuckDB's options can be set in a DSN.
+func ParseDSN(dsn string) (string, Config, error) {
+	path, query, found := strings.Cut(dsn, "?")
+	if !found {
+		return dsn, Config{}, nil
+	}
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return "", Config{}, errors.Wrapf(err, "invalid options in DSN %q", dsn)
+	}
+
+	config := Config{Options: make(map[string]string, len(values))}
+	for name, value := range values {
+		if len(value) != 1 {
+			return "", Config{}, errors.Errorf("option %s is given %d times in DSN %q", name, len(value), dsn)
+		}
+		config.Options[strings.ToLower(name)] = value[0]
+	}
+
+	return path, config, nil
+}
@@ -0,0 +1,150 @@
+package pduckdb
+
+import (
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDSN(t *testing.T) {
+	tests := []struct {
+		name    string
+		dsn     string
+		path    string
+		options map[string]string
+		wantErr bool
+	}{
+		{
+			name: "path only",
+			dsn:  "data/file.db",
+			path: "data/file.db",
+		},
+		{
+			name: "in-memory",
+			dsn:  ":memory:",
+			path: ":memory:",
+		},
+		{
+			name:    "options",
+			dsn:     "file.db?access_mode=read_only&threads=4&Memory_Limit=2GB",
+			path:    "file.db",
+			options: map[string]string{"access_mode": "read_only", "threads": "4", "memory_limit": "2GB"},
+		},
+		{
+			name:    "escaped values",
+			dsn:     ":memory:?temp_directory=%2Ftmp%2Fduck%20db",
+			path:    ":memory:",
+			options: map[string]string{"temp_directory": "/tmp/duck db"},
+		},
+		{
+			name:    "repeated option",
+			dsn:     "file.db?threads=1&threads=2",
+			wantErr: true,
+		},
+		{
+			name:    "invalid escape",
+			dsn:     "file.db?threads=%zz",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, config, err := ParseDSN(tt.dsn)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.path, path)
+			assert.Equal(t, tt.options, config.Options)
+		})
+	}
+}
+
+func TestConfigOptions(t *testing.T) {
+	config := Config{
+		Threads:              4,
+		MemoryLimit:          "1GB",
+		AccessMode:           AccessModeReadOnly,
+		TempDirectory:        "/tmp/spill",
+		MaxTempDirectorySize: "10GB",
+		DefaultOrder:         "desc",
+		Options: map[string]string{
+			"threads":             "8",
+			"enable_object_cache": "true",
+		},
+	}
+
+	// Fields take precedence over Options
+	assert.Equal(t, map[string]string{
+		"threads":                 "4",
+		"memory_limit":            "1GB",
+		"access_mode":             "read_only",
+		"temp_directory":          "/tmp/spill",
+		"max_temp_directory_size": "10GB",
+		"default_order":           "desc",
+		"enable_object_cache":     "true",
+	}, config.options())
+
+	assert.Empty(t, Config{}.options())
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"timezone": "Europe/Berlin"}, Config{TimeZone: berlin}.options())
+	assert.Empty(t, Config{TimeZone: time.Local}.options())
+}
+
+func TestOpenWithConfig(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	path := filepath.Join(t.TempDir(), "config.db")
+
+	// Create the database, so that it can be opened read-only
+	sqlDB, err := sql.Open("duckdb", path+"?threads=2&default_order=desc")
+	assert.NoError(t, err, "Error opening database")
+	_, err = sqlDB.Exec("CREATE TABLE t AS SELECT * FROM range(3) r(i)")
+	assert.NoError(t, err)
+
+	var threads string
+	err = sqlDB.QueryRow("SELECT current_setting('threads')").Scan(&threads)
+	assert.NoError(t, err)
+	assert.Equal(t, "2", threads)
+
+	var first int64
+	err = sqlDB.QueryRow("SELECT i FROM t ORDER BY i").Scan(&first)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), first)
+	assert.NoError(t, sqlDB.Close())
+
+	connector, err := NewConnectorWithConfig(path, Config{AccessMode: AccessModeReadOnly})
+	assert.NoError(t, err, "Error opening read-only database")
+	readOnly := sql.OpenDB(connector)
+	defer func() {
+		assert.NoError(t, readOnly.Close())
+	}()
+
+	var count int64
+	err = readOnly.QueryRow("SELECT count(*) FROM t").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	_, err = readOnly.Exec("INSERT INTO t VALUES (3)")
+	assert.ErrorContains(t, err, "read-only")
+
+	// Invalid options and open failures report DuckDB's error
+	_, err = NewConnector(":memory:?no_such_option=1")
+	var duckErr *Error
+	assert.True(t, errors.As(err, &duckErr), "Expected *Error, got %v", err)
+	assert.ErrorIs(t, err, &Error{Type: ErrorTypeInvalidConfiguration})
+
+	_, err = NewConnectorWithConfig(filepath.Join(t.TempDir(), "missing.db"), Config{AccessMode: AccessModeReadOnly})
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "failed to open database")
+}
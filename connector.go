@@ -0,0 +1,140 @@
+package pduckdb
+
+import (
+	"context"
+	"database/sql/driver"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Connector implements database/sql/driver.Connector.
+// A Connector owns a single DuckDB database handle and every connection it
+// creates shares that handle, so pooled connections opened against an
+// in-memory database all see the same data.
+//
+// The database is reference counted: it is closed once the Connector itself
+// and every connection created from it have been closed.
+type Connector struct {
+	driver *Driver
+	db     *DuckDB
+
+	mu     sync.Mutex
+	refs   int
+	closed bool
+}
+
+// NewConnector opens the database described by dsn and returns a Connector
+// that can be passed to sql.OpenDB. The dsn may carry configuration options,
+// like "file.db?access_mode=read_only&threads=4"; see ParseDSN.
+func NewConnector(dsn string) (*Connector, error) {
+	return newConnector(&Driver{}, dsn)
+}
+
+// NewConnectorWithConfig opens the database at path with the given configuration
+// and returns a Connector that can be passed to sql.OpenDB:
+//
+//	connector, err := pduckdb.NewConnectorWithConfig("reports.db", pduckdb.Config{
+//		AccessMode: pduckdb.AccessModeReadOnly,
+//		Threads:    4,
+//	})
+//	db := sql.OpenDB(connector)
+func NewConnectorWithConfig(path string, config Config) (*Connector, error) {
+	db, err := NewDuckDBWithConfig(path, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return connectorFor(&Driver{}, db), nil
+}
+
+func newConnector(d *Driver, dsn string) (*Connector, error) {
+	db, err := NewDuckDB(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return connectorFor(d, db), nil
+}
+
+func connectorFor(d *Driver, db *DuckDB) *Connector {
+	return &Connector{
+		driver: d,
+		db:     db,
+		refs:   1, // Held by the Connector itself until Close is called
+	}
+}
+
+// Connect returns a new connection to the shared database.
+// Implements driver.Connector
+func (c *Connector) Connect(ctx context.Context) (driver.Conn, error) {
+	// Check for context cancellation
+	if ctx.Done() != nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+	}
+
+	if err := c.acquire(); err != nil {
+		return nil, err
+	}
+
+	conn, err := c.db.Connect()
+	if err != nil {
+		c.release()
+		return nil, err
+	}
+
+	return &Conn{
+		connector: c,
+		conn:      conn,
+	}, nil
+}
+
+// Driver returns the underlying driver of the connector.
+// Implements driver.Connector
+func (c *Connector) Driver() driver.Driver {
+	return c.driver
+}
+
+// Close releases the Connector's reference to the database.
+// The database is closed as soon as no connection is using it anymore.
+// Implements io.Closer, which database/sql calls from DB.Close.
+func (c *Connector) Close() error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	c.mu.Unlock()
+
+	c.release()
+	return nil
+}
+
+// acquire takes a reference to the database for a new connection
+func (c *Connector) acquire() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed || c.refs == 0 {
+		return errors.New("connector is closed")
+	}
+	c.refs++
+	return nil
+}
+
+// release drops a reference and closes the database when it was the last one
+func (c *Connector) release() {
+	c.mu.Lock()
+	c.refs--
+	last := c.refs == 0
+	c.mu.Unlock()
+
+	if last {
+		c.db.Close()
+	}
+}
@@ -0,0 +1,115 @@
+package pduckdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+	"github.com/stretchr/testify/assert"
+)
+
+// testConnector creates a Connector backed by a mock database
+func testConnector(closeCalls *int) *Connector {
+	db := testDuckDB()
+	db.db.Close = func(_ *duckdb.DuckDBDatabase) {
+		*closeCalls++
+	}
+
+	return &Connector{
+		driver: &Driver{},
+		db:     db,
+		refs:   1,
+	}
+}
+
+func TestConnectorReferenceCounting(t *testing.T) {
+	var closeCalls int
+	connector := testConnector(&closeCalls)
+
+	conn1, err := connector.Connect(context.Background())
+	assert.NoError(t, err, "Error connecting")
+	conn2, err := connector.Connect(context.Background())
+	assert.NoError(t, err, "Error connecting")
+
+	// Closing the connector must keep the database open for live connections
+	assert.NoError(t, connector.Close())
+	assert.Equal(t, 0, closeCalls, "Database closed while connections are open")
+
+	// Closing the connector twice must not release another reference
+	assert.NoError(t, connector.Close())
+	assert.Equal(t, 0, closeCalls, "Database closed while connections are open")
+
+	assert.NoError(t, conn1.Close())
+	assert.Equal(t, 0, closeCalls, "Database closed while a connection is open")
+
+	// Closing a connection twice must not release another reference
+	assert.NoError(t, conn1.Close())
+	assert.Equal(t, 0, closeCalls, "Database closed while a connection is open")
+
+	assert.NoError(t, conn2.Close())
+	assert.Equal(t, 1, closeCalls, "Database should be closed after the last connection")
+
+	// No new connections after the connector is closed
+	_, err = connector.Connect(context.Background())
+	assert.Error(t, err, "Expected error connecting through a closed connector")
+}
+
+func TestConnectorCloseWithoutConnections(t *testing.T) {
+	var closeCalls int
+	connector := testConnector(&closeCalls)
+
+	assert.NoError(t, connector.Close())
+	assert.Equal(t, 1, closeCalls, "Database should be closed with the connector")
+}
+
+func TestConnectorCanceledContext(t *testing.T) {
+	var closeCalls int
+	connector := testConnector(&closeCalls)
+	defer func() {
+		_ = connector.Close()
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := connector.Connect(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestSharedInMemoryDatabase(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	ctx := context.Background()
+
+	// Hold two distinct pooled connections at the same time
+	conn1, err := sqlDB.Conn(ctx)
+	assert.NoError(t, err, "Error getting first connection")
+	defer func() {
+		_ = conn1.Close()
+	}()
+	conn2, err := sqlDB.Conn(ctx)
+	assert.NoError(t, err, "Error getting second connection")
+	defer func() {
+		_ = conn2.Close()
+	}()
+
+	_, err = conn1.ExecContext(ctx, "CREATE TABLE shared (id INTEGER)")
+	assert.NoError(t, err, "Error creating table")
+	_, err = conn1.ExecContext(ctx, "INSERT INTO shared VALUES (42)")
+	assert.NoError(t, err, "Error inserting value")
+
+	// The second connection must see the table created by the first one
+	var id int
+	err = conn2.QueryRowContext(ctx, "SELECT id FROM shared").Scan(&id)
+	assert.NoError(t, err, "Error querying table from second connection")
+	assert.Equal(t, 42, id)
+}
@@ -0,0 +1,16 @@
+package pduckdb
+
+import (
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+)
+
+// Decimal is an exact DECIMAL value, Unscaled / 10^Scale.
+// DECIMAL columns are read as Decimal, and Decimal arguments are bound exactly,
+// without rounding through float64. Decimal implements sql.Scanner, so it can
+// also receive DECIMAL, integer and text results.
+type Decimal = duckdb.Decimal
+
+// ParseDecimal parses a decimal number like "-123.45" into a Decimal
+func ParseDecimal(s string) (Decimal, error) {
+	return duckdb.ParseDecimal(s)
+}
@@ -0,0 +1,71 @@
+package pduckdb
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec("CREATE TABLE ledger (id INTEGER, small DECIMAL(4, 2), amount DECIMAL(18, 4), big DECIMAL(38, 10))")
+	assert.NoError(t, err)
+
+	big, err := ParseDecimal("1234567890123456789012345678.0123456789")
+	assert.NoError(t, err)
+
+	// Decimals, strings and floats are bound exactly
+	_, err = sqlDB.Exec("INSERT INTO ledger VALUES (1, ?, ?, ?)", "12.34", 0.1, big)
+	assert.NoError(t, err)
+	_, err = sqlDB.Exec("INSERT INTO ledger VALUES (2, ?, ?, ?)", -0.01, "99999999999999.9999", "-0.0000000001")
+	assert.NoError(t, err)
+
+	var small, amount, got Decimal
+	err = sqlDB.QueryRow("SELECT small, amount, big FROM ledger WHERE id = 1").Scan(&small, &amount, &got)
+	assert.NoError(t, err)
+	assert.Equal(t, "12.34", small.String())
+	assert.Equal(t, uint8(4), small.Width)
+	assert.Equal(t, uint8(2), small.Scale)
+	assert.Equal(t, "0.1000", amount.String())
+	assert.Equal(t, big.String(), got.String())
+
+	// Sums of decimals stay exact
+	var total Decimal
+	err = sqlDB.QueryRow("SELECT sum(amount) FROM ledger").Scan(&total)
+	assert.NoError(t, err)
+	assert.Equal(t, "100000000000000.0999", total.String())
+
+	// Parameters compared with DECIMAL columns
+	var id int32
+	err = sqlDB.QueryRow("SELECT id FROM ledger WHERE big = ?", "-0.0000000001").Scan(&id)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), id)
+
+	// Decimals can be scanned into floats, and into strings when cast to VARCHAR
+	var text string
+	var f float64
+	err = sqlDB.QueryRow("SELECT small::VARCHAR, small FROM ledger WHERE id = 2").Scan(&text, &f)
+	assert.NoError(t, err)
+	assert.Equal(t, "-0.01", text)
+	assert.Equal(t, -0.01, f)
+
+	rows, err := sqlDB.Query("SELECT amount FROM ledger")
+	assert.NoError(t, err)
+	types, err := rows.ColumnTypes()
+	assert.NoError(t, err)
+	precision, scale, ok := types[0].DecimalSize()
+	assert.True(t, ok)
+	assert.Equal(t, int64(18), precision)
+	assert.Equal(t, int64(4), scale)
+	assert.NoError(t, rows.Close())
+}
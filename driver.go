@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	"fmt"
 	"io"
+	"math/big"
 	"reflect"
 
 	"github.com/pkg/errors"
@@ -22,32 +24,54 @@ type Driver struct{}
 
 // Open returns a new connection to the database.
 // The dsn is a connection string for the database.
+// The returned connection owns its database, which is closed together with it.
+// database/sql uses OpenConnector instead, so that pooled connections share one database.
 func (d *Driver) Open(dsn string) (driver.Conn, error) {
-	db, err := NewDuckDB(dsn)
+	connector, err := newConnector(d, dsn)
 	if err != nil {
 		return nil, err
 	}
 
-	conn, err := db.Connect()
+	conn, err := connector.Connect(context.Background())
+	// Hand the database over to the connection; it is closed when the connection is.
+	_ = connector.Close()
 	if err != nil {
-		db.Close()
 		return nil, err
 	}
 
-	return &Conn{
-		db:   db,
-		conn: conn,
-	}, nil
+	return conn, nil
+}
+
+// OpenConnector returns a connector that opens the database once and
+// creates every connection against that same database.
+// Implements driver.DriverContext
+func (d *Driver) OpenConnector(dsn string) (driver.Connector, error) {
+	return newConnector(d, dsn)
 }
 
 // Conn implements database/sql/driver.Conn
 type Conn struct {
-	db   *DuckDB
-	conn *duckdb.Connection
+	connector *Connector
+	conn      *duckdb.Connection
+	closed    bool
+	// rows are the open rows of a streaming result, which running
+	// another statement on the connection would invalidate
+	rows *Rows
+}
+
+// bufferRows reads the remaining chunks of the open streaming rows into memory,
+// so that they stay readable while the connection runs another statement
+func (c *Conn) bufferRows() {
+	if c.rows != nil {
+		c.rows.buffer()
+		c.rows = nil
+	}
 }
 
 // Prepare returns a prepared statement, bound to this connection.
 func (c *Conn) Prepare(query string) (driver.Stmt, error) {
+	c.bufferRows()
+
 	// Create a new prepared statement using DuckDB's native prepare function
 	preparedStmt, err := c.conn.Prepare(query)
 	if err != nil {
@@ -55,6 +79,7 @@ func (c *Conn) Prepare(query string) (driver.Stmt, error) {
 	}
 
 	return &Stmt{
+		c:            c,
 		conn:         c.conn,
 		preparedStmt: preparedStmt,
 	}, nil
@@ -89,7 +114,15 @@ func (c *Conn) ExecContext(ctx context.Context, query string, args []driver.Name
 
 	if len(args) == 0 {
 		// No parameters, execute the query directly
+		c.bufferRows()
+		stop := watchContext(ctx, c.conn)
 		result, err := c.conn.Query(query)
+		if ctxErr := stop(); ctxErr != nil {
+			if err == nil {
+				result.Close()
+			}
+			return nil, ctxErr
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -130,13 +163,23 @@ func (c *Conn) QueryContext(ctx context.Context, query string, args []driver.Nam
 	}
 
 	if len(args) == 0 {
-		// No parameters, execute the query directly
-		result, err := c.conn.Query(query)
+		// No parameters, stream the result of the query directly
+		c.bufferRows()
+		stop := watchContext(ctx, c.conn)
+		result, err := c.conn.QueryStreaming(query)
+		if ctxErr := stop(); ctxErr != nil {
+			if err == nil {
+				result.Close()
+			}
+			return nil, ctxErr
+		}
 		if err != nil {
 			return nil, err
 		}
 		// Dont' close result here, as we need to return it
-		return newRows(result), nil
+		c.rows = newRows(ctx, c.conn, result)
+		c.rows.parent = c
+		return c.rows, nil
 	}
 
 	// Prepare the statement
@@ -144,17 +187,17 @@ func (c *Conn) QueryContext(ctx context.Context, query string, args []driver.Nam
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		if closeErr := stmt.Close(); closeErr != nil {
-			// Log the error since we can't return it
-			// In a real production environment, you'd want to use a logger here
-			// For now, we'll just ignore it - the staticcheck linter will be satisfied
-			_ = closeErr
-		}
-	}()
 
 	// Execute the statement
-	return stmt.(driver.StmtQueryContext).QueryContext(ctx, args)
+	rows, err := stmt.(driver.StmtQueryContext).QueryContext(ctx, args)
+	if err != nil {
+		_ = stmt.Close()
+		return nil, err
+	}
+
+	// The statement must stay open while its rows are streamed
+	rows.(*Rows).stmt = stmt
+	return rows, nil
 }
 
 // Ping implements driver.Pinger
@@ -169,6 +212,7 @@ func (c *Conn) Ping(ctx context.Context) error {
 	}
 
 	// Execute a simple query to check if the connection is still valid
+	c.bufferRows()
 	result, err := c.conn.Query("SELECT 1")
 	if err != nil {
 		return driver.ErrBadConn
@@ -177,24 +221,101 @@ func (c *Conn) Ping(ctx context.Context) error {
 	return nil
 }
 
+// CheckNamedValue passes through the arguments the driver binds itself,
+// and leaves the others to the default conversion of database/sql.
+// Implements driver.NamedValueChecker
+func (c *Conn) CheckNamedValue(nv *driver.NamedValue) error {
+	if _, ok := nv.Value.(driver.Valuer); ok {
+		return driver.ErrSkip
+	}
+
+	switch v := nv.Value.(type) {
+	case *big.Int:
+		if v == nil {
+			nv.Value = nil
+		}
+		return nil
+	case [16]byte:
+		// A raw UUID
+		return nil
+	case Union, *Union:
+		// Bound with the type of the tagged member
+		return nil
+	case io.Reader:
+		// Read into a BLOB when the argument is bound
+		return nil
+	default:
+		if duckdb.IsNestedValue(v) {
+			// Slices, maps and structs bind to LIST, ARRAY, MAP and STRUCT parameters
+			return nil
+		}
+		return driver.ErrSkip
+	}
+}
+
+// watchContext interrupts the query running on conn once ctx is done.
+// The returned function stops watching and reports ctx.Err() if the query was interrupted,
+// so that callers return the context error instead of DuckDB's interrupt error.
+func watchContext(ctx context.Context, conn *duckdb.Connection) func() error {
+	if ctx.Done() == nil {
+		// The context can never be canceled
+		return func() error { return nil }
+	}
+
+	done := make(chan struct{})
+	interrupted := make(chan bool, 1)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Interrupt()
+			interrupted <- true
+		case <-done:
+			interrupted <- false
+		}
+	}()
+
+	return func() error {
+		close(done)
+		// Wait for the watcher, so that no interrupt can hit a later query
+		if <-interrupted {
+			return ctx.Err()
+		}
+		return nil
+	}
+}
+
 // Close closes the connection.
+// The shared database is closed when its last connection and connector are gone.
+// Closing a connection again is a no-op.
 func (c *Conn) Close() error {
-	c.db.Close() // This will close the connection as well
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+
+	if c.rows != nil {
+		// database/sql closes rows before their connection, but Open's callers may not
+		_ = c.rows.Close()
+	}
+	c.conn.Close()
+	c.connector.release()
 	return nil
 }
 
 // Begin starts and returns a new transaction.
 func (c *Conn) Begin() (driver.Tx, error) {
 	// Execute BEGIN statement
+	c.bufferRows()
 	err := c.conn.Execute("BEGIN TRANSACTION")
 	if err != nil {
 		return nil, err
 	}
-	return &Tx{conn: c.conn}, nil
+	return &Tx{c: c}, nil
 }
 
 // Stmt implements database/sql/driver.Stmt
 type Stmt struct {
+	c            *Conn
 	conn         *duckdb.Connection
 	preparedStmt *duckdb.PreparedStatement
 }
@@ -225,37 +346,111 @@ func (s *Stmt) Query(args []driver.Value) (driver.Rows, error) {
 	return nil, errors.New("not implemented, use QueryContext instead")
 }
 
+// bindArgs binds the arguments of a statement execution.
+// Named arguments, like sql.Named("tenant", v), are bound to the parameter of the
+// same name, $tenant; other arguments are bound by their position.
+// Every parameter of the statement must be bound exactly once.
+func bindArgs(ps *duckdb.PreparedStatement, args []driver.NamedValue) error {
+	count := int(ps.ParameterCount())
+	// Parameter indices in DuckDB are 1-based
+	bound := make([]bool, count+1)
+
+	for _, arg := range args {
+		paramIdx := arg.Ordinal
+		if arg.Name != "" {
+			idx, err := ps.ParameterIndex(arg.Name)
+			if err != nil {
+				return err
+			}
+			paramIdx = idx
+		}
+		if paramIdx < 1 || paramIdx > count {
+			return errors.Errorf("argument %d has no parameter, the statement has %d parameters", arg.Ordinal, count)
+		}
+		if bound[paramIdx] {
+			return errors.Errorf("parameter %s is bound more than once", parameterName(ps, paramIdx))
+		}
+
+		if err := ps.BindParameter(paramIdx, arg.Value); err != nil {
+			return errors.Wrapf(err, "parameter %s", parameterName(ps, paramIdx))
+		}
+		bound[paramIdx] = true
+	}
+
+	for paramIdx := 1; paramIdx <= count; paramIdx++ {
+		if !bound[paramIdx] {
+			return errors.Errorf("missing argument for parameter %s", parameterName(ps, paramIdx))
+		}
+	}
+
+	return nil
+}
+
+// parameterName returns the name of a parameter as written in SQL, like $tenant or $1
+func parameterName(ps *duckdb.PreparedStatement, paramIdx int) string {
+	name, err := ps.ParameterName(paramIdx)
+	if err != nil || name == "" {
+		return fmt.Sprintf("$%d", paramIdx)
+	}
+	return "$" + name
+}
+
 // Tx implements database/sql/driver.Tx
 type Tx struct {
-	conn *duckdb.Connection
+	c *Conn
 }
 
 // Commit commits the transaction.
 func (tx *Tx) Commit() error {
-	return tx.conn.Execute("COMMIT")
+	tx.c.bufferRows()
+	return tx.c.conn.Execute("COMMIT")
 }
 
 // Rollback aborts the transaction.
 func (tx *Tx) Rollback() error {
-	return tx.conn.Execute("ROLLBACK")
+	tx.c.bufferRows()
+	return tx.c.conn.Execute("ROLLBACK")
 }
 
 // Rows implements database/sql/driver.Rows
+// Rows are decoded from the result one data chunk at a time, so a streaming
+// result never needs to be held in memory as a whole. If the connection runs
+// another statement while the rows are open, which would invalidate the streaming
+// result, the remaining chunks are fetched into memory first.
 type Rows struct {
+	ctx         context.Context
+	conn        *duckdb.Connection
 	result      *duckdb.Result
-	columnCnt   int64
-	rowCnt      int64
-	currentRow  int64
 	columnNames []string
-}
+	readers     []*duckdb.ValueReader
+	chunk       *duckdb.DataChunk
+	vectors     []*duckdb.Vector
+	chunkSize   int
+	chunkRow    int
+	done        bool
+	// buffered are chunks fetched ahead by buffer, and err the error that ended them
+	buffered []*duckdb.DataChunk
+	err      error
+	// parent is the connection that tracks the rows while they stream
+	parent *Conn
+	// stmt is a statement owned by the rows, closed together with them
+	stmt driver.Stmt
+}
+
+func newRows(ctx context.Context, conn *duckdb.Connection, result *duckdb.Result) *Rows {
+	readers := make([]*duckdb.ValueReader, result.ColumnCount())
+	for i := range readers {
+		readers[i] = duckdb.NewValueReader(result.Db, result.ColumnLogicalType(int64(i)))
+		readers[i].SetLocation(conn.Location())
+	}
 
-func newRows(result *duckdb.Result) *Rows {
 	return &Rows{
+		ctx:         ctx,
+		conn:        conn,
 		result:      result,
-		columnCnt:   result.ColumnCount(),
-		rowCnt:      result.RowCount(),
-		currentRow:  0,
 		columnNames: result.ColumnNames(),
+		readers:     readers,
+		vectors:     make([]*duckdb.Vector, len(readers)),
 	}
 }
 
@@ -266,129 +461,165 @@ func (r *Rows) Columns() []string {
 
 // Close closes the rows iterator.
 func (r *Rows) Close() error {
+	if r.parent != nil && r.parent.rows == r {
+		r.parent.rows = nil
+	}
+	if r.chunk != nil {
+		r.chunk.Close()
+		r.chunk = nil
+	}
+	for _, chunk := range r.buffered {
+		chunk.Close()
+	}
+	r.buffered = nil
+	for _, reader := range r.readers {
+		reader.Close()
+	}
+	r.readers = nil
 	r.result.Close()
+	if r.stmt != nil {
+		return r.stmt.Close()
+	}
 	return nil
 }
 
 // Next is called to populate the next row of data into the provided slice.
 func (r *Rows) Next(dest []driver.Value) error {
-	if r.currentRow >= r.rowCnt {
-		return io.EOF
+	for r.chunk == nil || r.chunkRow >= r.chunkSize {
+		if err := r.nextChunk(); err != nil {
+			return err
+		}
 	}
 
-	for i := int64(0); i < int64(r.columnCnt); i++ {
-		logicalType := r.result.ColumnLogicalType(i)
-		typeID := r.result.Db.GetTypeID(logicalType)
-		typeAlias := goString(r.result.Db.LogicalTypeGetAlias(logicalType))
+	for i, vector := range r.vectors {
+		dest[i] = columnValue(vector.Value(r.chunkRow))
+	}
 
-		switch typeID {
-		case duckdb.DuckDBTypeBoolean:
-			if val, ok := r.result.ValueBoolean(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		case duckdb.DuckDBTypeTinyint:
-			if val, ok := r.result.ValueInt8(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		case duckdb.DuckDBTypeSmallint:
-			if val, ok := r.result.ValueInt16(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		case duckdb.DuckDBTypeInteger:
-			if val, ok := r.result.ValueInt32(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		case duckdb.DuckDBTypeBigint:
-			if val, ok := r.result.ValueInt64(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		case duckdb.DuckDBTypeUTinyint:
-			if val, ok := r.result.ValueUint8(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		case duckdb.DuckDBTypeUSmallint:
-			if val, ok := r.result.ValueUint16(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		case duckdb.DuckDBTypeUInteger:
-			if val, ok := r.result.ValueUint32(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		case duckdb.DuckDBTypeUBigint:
-			if val, ok := r.result.ValueUint64(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		case duckdb.DuckDBTypeFloat:
-			if val, ok := r.result.ValueFloat(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		case duckdb.DuckDBTypeDouble:
-			if val, ok := r.result.ValueDouble(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		case duckdb.DuckDBTypeDate:
-			if val, ok := r.result.ValueDate(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		case duckdb.DuckDBTypeTime:
-			if val, ok := r.result.ValueTime(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		case duckdb.DuckDBTypeTimestamp:
-			if val, ok := r.result.ValueTimestamp(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		case duckdb.DuckDBTypeVarchar:
-			if typeAlias == "JSON" {
-				if val, ok := r.result.ValueVarchar(i, int32(r.currentRow)); ok {
-					dest[i] = val
-					continue
-				}
-			}
-			if val, ok := r.result.ValueString(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
-			}
-		default:
-			if val, ok := r.result.ValueString(i, int32(r.currentRow)); ok {
-				dest[i] = val
-				continue
+	r.chunkRow++
+	return nil
+}
+
+// columnValue returns the driver value of a column value. Values of the
+// exact types are passed as their text, which their Scan methods parse,
+// so the columns also scan into strings and numbers.
+func columnValue(value any) driver.Value {
+	switch v := value.(type) {
+	case duckdb.Decimal:
+		return v.String()
+	case duckdb.Interval:
+		return v.String()
+	case *big.Int:
+		return v.String()
+	case duckdb.UUID:
+		return v.String()
+	case duckdb.BitString:
+		return v.String()
+	default:
+		return value
+	}
+}
+
+// nextChunk replaces the current chunk with the next chunk of the result
+func (r *Rows) nextChunk() error {
+	if r.chunk != nil {
+		r.chunk.Close()
+		r.chunk = nil
+	}
+
+	var chunk *duckdb.DataChunk
+	if len(r.buffered) > 0 {
+		chunk = r.buffered[0]
+		r.buffered = r.buffered[1:]
+	} else {
+		if r.done {
+			if r.err != nil {
+				return r.err
 			}
+			return io.EOF
+		}
+		var err error
+		chunk, err = r.fetch()
+		if err != nil {
+			return err
 		}
-
-		// If all attempts fail, set to nil
-		dest[i] = nil
 	}
 
-	r.currentRow++
+	r.chunk = chunk
+	r.chunkSize = chunk.Size()
+	r.chunkRow = 0
+	for i, reader := range r.readers {
+		r.vectors[i] = reader.Vector(chunk.Vector(i))
+	}
 	return nil
 }
 
+// fetch fetches the next chunk of the result, returning io.EOF once it is exhausted
+func (r *Rows) fetch() (*duckdb.DataChunk, error) {
+	// Fetching a chunk of a streaming result executes the query,
+	// so it is interrupted when the query's context is done
+	stop := watchContext(r.ctx, r.conn)
+	chunk, err := r.result.FetchChunk()
+	if ctxErr := stop(); ctxErr != nil {
+		if chunk != nil {
+			chunk.Close()
+		}
+		r.done = true
+		return nil, ctxErr
+	}
+	if err != nil {
+		r.done = true
+		return nil, err
+	}
+	if chunk == nil {
+		r.done = true
+		return nil, io.EOF
+	}
+	return chunk, nil
+}
+
+// buffer fetches the remaining chunks of the result. An error that ends the
+// result is returned by Next after the chunks fetched before it.
+func (r *Rows) buffer() {
+	for !r.done {
+		chunk, err := r.fetch()
+		if err != nil {
+			if err != io.EOF {
+				r.err = err
+			}
+			return
+		}
+		r.buffered = append(r.buffered, chunk)
+	}
+}
+
 // ColumnTypeScanType returns column type information.
 // Implements RowsColumnTypeScanType
 func (r *Rows) ColumnTypeScanType(index int) reflect.Type {
-	colType := r.result.ColumnType(int64(index))
-	return colType.GoType()
+	scanType := r.readers[index].GoType()
+	// Big integers are passed as text, which HugeInt scans
+	if scanType == bigIntType {
+		return reflect.TypeOf(HugeInt{})
+	}
+	return scanType
 }
 
-// ColumnTypeDatabaseTypeName returns column type information.
+// ColumnTypeDatabaseTypeName returns the SQL name of the column type,
+// including the values of an ENUM, like "ENUM('active', 'closed')".
 // Implements RowsColumnTypeDatabaseTypeName
 func (r *Rows) ColumnTypeDatabaseTypeName(index int) string {
-	return r.result.ColumnName(int64(index))
+	return r.readers[index].TypeName()
+}
+
+// ColumnTypeEnumValues returns the values of an ENUM column
+func (r *Rows) ColumnTypeEnumValues(index int) ([]string, bool) {
+	values := r.readers[index].EnumValues()
+	return values, values != nil
+}
+
+// ColumnTypeUnionMembers returns the member tags of a UNION column
+func (r *Rows) ColumnTypeUnionMembers(index int) ([]string, bool) {
+	members := r.readers[index].UnionMembers()
+	return members, members != nil
 }
 
 // ColumnTypeNullable returns column type information.
@@ -419,11 +650,12 @@ func (c *Conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, e
 
 	// Use a simplified transaction begin statement that's compatible with DuckDB
 	// Ignore isolation level and read-only settings for now as they might not be supported
+	c.bufferRows()
 	err := c.conn.Execute("BEGIN TRANSACTION")
 	if err != nil {
 		return nil, err
 	}
-	return &Tx{conn: c.conn}, nil
+	return &Tx{c: c}, nil
 }
 
 // StmtExecContext implements driver.StmtExecContext
@@ -442,18 +674,20 @@ func (s *Stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (drive
 		}
 	}
 
-	// Bind parameters
-	for i, arg := range args {
-		// Parameter indices in DuckDB are 1-based
-		paramIdx := i + 1
-		err := s.preparedStmt.BindParameter(paramIdx, arg.Value)
-		if err != nil {
-			return nil, err
-		}
+	if err := bindArgs(s.preparedStmt, args); err != nil {
+		return nil, err
 	}
 
 	// Execute the prepared statement
+	s.c.bufferRows()
+	stop := watchContext(ctx, s.conn)
 	result, err := s.preparedStmt.Execute()
+	if ctxErr := stop(); ctxErr != nil {
+		if err == nil {
+			result.Close()
+		}
+		return nil, ctxErr
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -480,24 +714,28 @@ func (s *Stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driv
 		}
 	}
 
-	// Bind parameters
-	for i, arg := range args {
-		// Parameter indices in DuckDB are 1-based
-		paramIdx := i + 1
-		err := s.preparedStmt.BindParameter(paramIdx, arg.Value)
-		if err != nil {
-			return nil, err
-		}
+	if err := bindArgs(s.preparedStmt, args); err != nil {
+		return nil, err
 	}
 
-	// Execute the prepared statement
-	result, err := s.preparedStmt.Execute()
+	// Execute the prepared statement, streaming its result
+	s.c.bufferRows()
+	stop := watchContext(ctx, s.conn)
+	result, err := s.preparedStmt.ExecuteStreaming()
+	if ctxErr := stop(); ctxErr != nil {
+		if err == nil {
+			result.Close()
+		}
+		return nil, ctxErr
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	// Create and return rows
-	rows := newRows(result)
+	// Create and return rows, which the connection tracks while they stream
+	rows := newRows(ctx, s.conn, result)
+	rows.parent = s.c
+	s.c.rows = rows
 
 	return rows, nil
 }
@@ -520,6 +758,8 @@ func (r *Result) RowsAffected() (int64, error) {
 // Ensure our driver implements necessary interfaces
 var (
 	_ driver.Driver                         = (*Driver)(nil)
+	_ driver.DriverContext                  = (*Driver)(nil)
+	_ driver.Connector                      = (*Connector)(nil)
 	_ driver.Conn                           = (*Conn)(nil)
 	_ driver.Stmt                           = (*Stmt)(nil)
 	_ driver.StmtExecContext                = (*Stmt)(nil)
@@ -530,6 +770,7 @@ var (
 	_ driver.ExecerContext                  = (*Conn)(nil)
 	_ driver.QueryerContext                 = (*Conn)(nil)
 	_ driver.Pinger                         = (*Conn)(nil)
+	_ driver.NamedValueChecker              = (*Conn)(nil)
 	_ driver.Result                         = (*Result)(nil)
 	_ driver.Rows                           = (*Rows)(nil)
 	_ driver.RowsColumnTypeScanType         = (*Rows)(nil)
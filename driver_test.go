@@ -1,9 +1,16 @@
 package pduckdb
 
 import (
+	"bytes"
+	"context"
 	"database/sql"
+	"database/sql/driver"
+	"math/big"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
+	"unsafe"
 
 	"github.com/fpt/go-pduckdb/internal/duckdb"
 	"github.com/stretchr/testify/assert"
@@ -866,3 +873,578 @@ func TestUpdateStatement(t *testing.T) {
 		}
 	})
 }
+
+func TestStreamingRows(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	t.Run("ManyChunks", func(t *testing.T) {
+		// Spans many data chunks of 2048 rows, with NULLs mixed in
+		rows, err := sqlDB.Query("SELECT i, CASE WHEN i % 3 = 0 THEN NULL ELSE i::VARCHAR END FROM range(100000) t(i)")
+		assert.NoError(t, err, "Error querying range")
+		defer func() {
+			assert.NoError(t, rows.Close())
+		}()
+
+		var count, sum int64
+		var nulls int
+		for rows.Next() {
+			var i int64
+			var s sql.NullString
+			assert.NoError(t, rows.Scan(&i, &s))
+			count++
+			sum += i
+			if !s.Valid {
+				nulls++
+			}
+		}
+		assert.NoError(t, rows.Err())
+		assert.Equal(t, int64(100000), count)
+		assert.Equal(t, int64(100000*99999/2), sum)
+		assert.Equal(t, 33334, nulls)
+	})
+
+	t.Run("WithParameters", func(t *testing.T) {
+		rows, err := sqlDB.Query("SELECT i FROM range(?) t(i)", 5000)
+		assert.NoError(t, err, "Error querying range with parameter")
+		defer func() {
+			assert.NoError(t, rows.Close())
+		}()
+
+		var count int
+		for rows.Next() {
+			count++
+		}
+		assert.NoError(t, rows.Err())
+		assert.Equal(t, 5000, count)
+	})
+
+	t.Run("MultipleStatements", func(t *testing.T) {
+		rows, err := sqlDB.Query("CREATE TABLE multi AS SELECT 1 AS col; SELECT col FROM multi")
+		assert.NoError(t, err, "Error querying multiple statements")
+		defer func() {
+			assert.NoError(t, rows.Close())
+		}()
+
+		assert.True(t, rows.Next())
+		var col int
+		assert.NoError(t, rows.Scan(&col))
+		assert.Equal(t, 1, col)
+		assert.False(t, rows.Next())
+	})
+
+	t.Run("InterleavedStatements", func(t *testing.T) {
+		// Statements run on the same connection while rows stream read the rest of the rows first
+		tx, err := sqlDB.Begin()
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, tx.Rollback())
+		}()
+		_, err = tx.Exec("CREATE TABLE seen (i BIGINT)")
+		assert.NoError(t, err)
+
+		rows, err := tx.Query("SELECT i FROM range(10000) t(i)")
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, rows.Close())
+		}()
+
+		var count, sum int64
+		for rows.Next() {
+			var i int64
+			assert.NoError(t, rows.Scan(&i))
+			if i%2500 == 0 {
+				_, err = tx.Exec("INSERT INTO seen VALUES (?)", i)
+				assert.NoError(t, err)
+
+				var seen int64
+				assert.NoError(t, tx.QueryRow("SELECT count(*) FROM seen").Scan(&seen))
+				assert.Equal(t, i/2500+1, seen)
+			}
+			count++
+			sum += i
+		}
+		assert.NoError(t, rows.Err())
+		assert.Equal(t, int64(10000), count)
+		assert.Equal(t, int64(10000*9999/2), sum)
+	})
+
+	t.Run("ExecutionError", func(t *testing.T) {
+		// The error only surfaces while the rows are fetched
+		rows, err := sqlDB.Query("SELECT CASE WHEN i = 3000 THEN error('boom') ELSE i END FROM range(5000) t(i)")
+		if err != nil {
+			assert.Contains(t, err.Error(), "boom")
+			return
+		}
+		defer func() {
+			_ = rows.Close()
+		}()
+		for rows.Next() {
+		}
+		assert.Error(t, rows.Err())
+	})
+}
+
+func TestContextInterruptsQuery(t *testing.T) {
+	var closeCalls int
+	connector := testConnector(&closeCalls)
+	defer func() {
+		_ = connector.Close()
+	}()
+
+	// The mock query runs until the connection is interrupted
+	interrupt := make(chan struct{})
+	var queries, interrupts int
+	connector.db.db.Query = func(duckdb.DuckDBConnection, *byte, *duckdb.DuckDBResultRaw) duckdb.DuckDBState {
+		queries++
+		if queries > 1 {
+			return duckdb.DuckDBSuccess
+		}
+		<-interrupt
+		return duckdb.DuckDBError
+	}
+	connector.db.db.Interrupt = func(duckdb.DuckDBConnection) {
+		interrupts++
+		close(interrupt)
+	}
+
+	conn, err := connector.Connect(context.Background())
+	assert.NoError(t, err, "Error connecting")
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = conn.(*Conn).ExecContext(ctx, "SELECT 1", nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, interrupts)
+
+	// The connection remains usable and is not interrupted again
+	_, err = conn.(*Conn).ExecContext(context.Background(), "SELECT 1", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, interrupts)
+}
+
+func TestQueryTimeout(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	// Keep a single connection, so that the second query reuses the interrupted one
+	sqlDB.SetMaxOpenConns(1)
+
+	// A cross join that takes far longer than the timeout
+	const slowQuery = "SELECT count(*) FROM range(100000000) a, range(100000000) b WHERE a.range + b.range = 7"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	var count int64
+	err = sqlDB.QueryRowContext(ctx, slowQuery).Scan(&count)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 10*time.Second, "Query was not interrupted")
+
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = sqlDB.ExecContext(ctx, "CREATE TABLE t AS "+slowQuery)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The connection must still work after the interrupts
+	err = sqlDB.QueryRowContext(context.Background(), "SELECT 42").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), count)
+}
+
+// namedParamsConn returns a mock connection whose statements have the parameters $tenant and $start,
+// recording the bound values by parameter index
+func namedParamsConn(t *testing.T, bound map[int]any) (*Conn, func()) {
+	var closeCalls int
+	connector := testConnector(&closeCalls)
+	db := connector.db.db
+
+	names := []string{"tenant", "start"}
+	var handle, logicalType byte
+	db.Prepare = func(_ duckdb.DuckDBConnection, _ *byte, stmt *duckdb.DuckDBPreparedStatement) duckdb.DuckDBState {
+		*stmt = duckdb.DuckDBPreparedStatement(unsafe.Pointer(&handle))
+		return duckdb.DuckDBSuccess
+	}
+	db.DestroyPrepared = func(*duckdb.DuckDBPreparedStatement) {}
+	db.NumParams = func(duckdb.DuckDBPreparedStatement) int64 { return int64(len(names)) }
+	db.BindParameterIndex = func(_ duckdb.DuckDBPreparedStatement, idx *uint64, name *byte) duckdb.DuckDBState {
+		for i, n := range names {
+			if strings.EqualFold(n, duckdb.GoString(name)) {
+				*idx = uint64(i + 1)
+				return duckdb.DuckDBSuccess
+			}
+		}
+		return duckdb.DuckDBError
+	}
+	db.ParameterName = func(_ duckdb.DuckDBPreparedStatement, idx int64) *byte {
+		return duckdb.ToCString(names[idx-1])
+	}
+	db.Free = func(p unsafe.Pointer) { duckdb.FreeCString((*byte)(p)) }
+	db.ParamLogicalType = func(duckdb.DuckDBPreparedStatement, int64) duckdb.DuckDBLogicalType {
+		return duckdb.DuckDBLogicalType(unsafe.Pointer(&logicalType))
+	}
+	db.GetTypeID = func(duckdb.DuckDBLogicalType) duckdb.DuckDBType { return duckdb.DuckDBTypeBigint }
+	db.BindNull = func(_ duckdb.DuckDBPreparedStatement, idx int32) duckdb.DuckDBState {
+		bound[int(idx)] = nil
+		return duckdb.DuckDBSuccess
+	}
+	db.BindInt64 = func(_ duckdb.DuckDBPreparedStatement, idx int32, v int64) duckdb.DuckDBState {
+		bound[int(idx)] = v
+		return duckdb.DuckDBSuccess
+	}
+
+	conn, err := connector.Connect(context.Background())
+	assert.NoError(t, err, "Error connecting")
+	return conn.(*Conn), func() {
+		_ = conn.Close()
+		_ = connector.Close()
+	}
+}
+
+func TestBindNamedArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []driver.NamedValue
+		want    map[int]any
+		wantErr string
+	}{
+		{
+			name: "named",
+			args: []driver.NamedValue{
+				{Name: "start", Ordinal: 1, Value: int64(20)},
+				{Name: "tenant", Ordinal: 2, Value: int64(10)},
+			},
+			want: map[int]any{1: int64(10), 2: int64(20)},
+		},
+		{
+			name: "names are case-insensitive",
+			args: []driver.NamedValue{
+				{Name: "Tenant", Ordinal: 1, Value: int64(10)},
+				{Name: "START", Ordinal: 2, Value: nil},
+			},
+			want: map[int]any{1: int64(10), 2: nil},
+		},
+		{
+			name: "positional",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Value: int64(10)},
+				{Ordinal: 2, Value: int64(20)},
+			},
+			want: map[int]any{1: int64(10), 2: int64(20)},
+		},
+		{
+			name: "mixed",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Value: int64(10)},
+				{Name: "start", Ordinal: 2, Value: int64(20)},
+			},
+			want: map[int]any{1: int64(10), 2: int64(20)},
+		},
+		{
+			name: "unknown name",
+			args: []driver.NamedValue{
+				{Name: "tenant", Ordinal: 1, Value: int64(10)},
+				{Name: "end", Ordinal: 2, Value: int64(20)},
+			},
+			wantErr: "unknown parameter $end",
+		},
+		{
+			name: "bound twice",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Value: int64(10)},
+				{Name: "tenant", Ordinal: 2, Value: int64(20)},
+			},
+			wantErr: "parameter $tenant is bound more than once",
+		},
+		{
+			name: "missing",
+			args: []driver.NamedValue{
+				{Name: "tenant", Ordinal: 1, Value: int64(10)},
+			},
+			wantErr: "missing argument for parameter $start",
+		},
+		{
+			name: "too many",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Value: int64(10)},
+				{Ordinal: 2, Value: int64(20)},
+				{Ordinal: 3, Value: int64(30)},
+			},
+			wantErr: "argument 3 has no parameter, the statement has 2 parameters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bound := map[int]any{}
+			conn, closeConn := namedParamsConn(t, bound)
+			defer closeConn()
+
+			stmt, err := conn.Prepare("SELECT * FROM events WHERE tenant = $tenant AND ts >= $start")
+			assert.NoError(t, err)
+			defer func() {
+				_ = stmt.Close()
+			}()
+
+			err = bindArgs(stmt.(*Stmt).preparedStmt, tt.args)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, bound)
+		})
+	}
+}
+
+func TestNamedParameters(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec("CREATE TABLE events (tenant VARCHAR, day DATE)")
+	assert.NoError(t, err)
+	_, err = sqlDB.Exec("INSERT INTO events VALUES ('a', '2024-01-01'), ('a', '2024-02-01'), ('b', '2024-02-01')")
+	assert.NoError(t, err)
+
+	const query = "SELECT count(*) FROM events WHERE tenant = $tenant AND day >= $start_date"
+
+	var count int64
+	err = sqlDB.QueryRow(query, sql.Named("start_date", "2024-01-15"), sql.Named("tenant", "a")).Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// Named parameters are numbered in order of appearance
+	err = sqlDB.QueryRow(query, "b", "2024-01-01").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// Prepared statements can be executed with different named arguments
+	stmt, err := sqlDB.Prepare(query)
+	assert.NoError(t, err)
+	defer func() {
+		_ = stmt.Close()
+	}()
+	err = stmt.QueryRow(sql.Named("tenant", "a"), sql.Named("start_date", "2023-12-31")).Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	_, err = sqlDB.Exec("DELETE FROM events WHERE tenant = $tenant", sql.Named("tenant", "b"))
+	assert.NoError(t, err)
+
+	err = sqlDB.QueryRow(query, sql.Named("tenant", "a"), sql.Named("day", "2024-01-01")).Scan(&count)
+	assert.ErrorContains(t, err, "unknown parameter $day")
+
+	err = sqlDB.QueryRow(query, sql.Named("tenant", "a")).Scan(&count)
+	assert.Error(t, err)
+}
+
+func TestTemporalZeroValues(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	epoch := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var date, timeOfDay, timestamp, moonLanding time.Time
+	var nullDate sql.NullTime
+	err = sqlDB.QueryRow(`SELECT DATE '1970-01-01', TIME '00:00:00', TIMESTAMP '1970-01-01 00:00:00',
+		TIMESTAMP '1969-07-20 20:17:40', NULL::DATE`).Scan(&date, &timeOfDay, &timestamp, &moonLanding, &nullDate)
+	assert.NoError(t, err)
+	assert.Equal(t, epoch, date)
+	assert.Equal(t, epoch, timeOfDay)
+	assert.Equal(t, epoch, timestamp)
+	assert.Equal(t, time.Date(1969, 7, 20, 20, 17, 40, 0, time.UTC), moonLanding)
+	assert.False(t, nullDate.Valid)
+
+	// The native API detects NULL the same way
+	db, err := NewDuckDB(":memory:")
+	assert.NoError(t, err)
+	defer db.Close()
+	conn, err := db.Connect()
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	result, err := conn.Query("SELECT DATE '1970-01-01', TIME '00:00:00', NULL::TIMESTAMP")
+	assert.NoError(t, err)
+	defer result.Close()
+
+	d, ok := result.ValueDate(0, 0)
+	assert.True(t, ok)
+	assert.Equal(t, epoch, d)
+	tm, ok := result.ValueTime(1, 0)
+	assert.True(t, ok)
+	assert.Equal(t, epoch, tm)
+	_, ok = result.ValueTimestamp(2, 0)
+	assert.False(t, ok)
+}
+
+func TestCheckNamedValue(t *testing.T) {
+	c := &Conn{}
+	reader := strings.NewReader("payload")
+	var nilInt *big.Int
+
+	tests := []struct {
+		name     string
+		value    any
+		expected any
+		skip     bool
+	}{
+		{name: "big integer", value: big.NewInt(42), expected: big.NewInt(42)},
+		{name: "nil big integer", value: nilInt, expected: nil},
+		{name: "reader", value: reader, expected: reader},
+		{name: "raw UUID", value: [16]byte{1}, expected: [16]byte{1}},
+		{name: "union", value: Union{Tag: "n", Value: 1}, expected: Union{Tag: "n", Value: 1}},
+		{name: "embedding", value: []float32{0.5, 1}, expected: []float32{0.5, 1}},
+		{name: "array of doubles", value: [2]float64{1, 2}, expected: [2]float64{1, 2}},
+		{name: "strings", value: []string{"a"}, expected: []string{"a"}},
+		{name: "map", value: map[string]int{"a": 1}, expected: map[string]int{"a": 1}},
+		{name: "map entries", value: []MapEntry{{Key: 1, Value: "a"}}, expected: []MapEntry{{Key: 1, Value: "a"}}},
+		{name: "struct", value: struct{ A int }{A: 1}, expected: struct{ A int }{A: 1}},
+		{name: "time", value: time.Time{}, skip: true},
+		{name: "valuer", value: HugeInt{Int: big.NewInt(1)}, skip: true},
+		{name: "string", value: "text", skip: true},
+		{name: "bytes", value: []byte{0, 1}, skip: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nv := &driver.NamedValue{Ordinal: 1, Value: tt.value}
+			err := c.CheckNamedValue(nv)
+			if tt.skip {
+				assert.ErrorIs(t, err, driver.ErrSkip)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, nv.Value)
+		})
+	}
+}
+
+func TestBlob(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec("CREATE TABLE payloads (id INTEGER, data BLOB)")
+	assert.NoError(t, err)
+
+	// Zero bytes and bytes that are not valid UTF-8 survive the round trip
+	binary := []byte{0x08, 0x96, 0x01, 0x00, 0xff, 0x00, 0x12}
+	long := bytes.Repeat([]byte{0, 1, 2, 0xfe}, 1000)
+	_, err = sqlDB.Exec("INSERT INTO payloads VALUES (1, ?), (2, ?), (3, ?), (4, ?)",
+		binary, long, bytes.NewReader(binary), []byte{})
+	assert.NoError(t, err)
+
+	for id, expected := range map[int][]byte{1: binary, 2: long, 3: binary, 4: {}} {
+		var data []byte
+		err = sqlDB.QueryRow("SELECT data FROM payloads WHERE id = ?", id).Scan(&data)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, data, "blob %d", id)
+	}
+
+	var length int64
+	err = sqlDB.QueryRow("SELECT octet_length(data) FROM payloads WHERE id = 1").Scan(&length)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(binary)), length)
+
+	var id int32
+	err = sqlDB.QueryRow("SELECT id FROM payloads WHERE data = ?", binary).Scan(&id)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), id)
+
+	rows, err := sqlDB.Query("SELECT data FROM payloads")
+	assert.NoError(t, err)
+	types, err := rows.ColumnTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, reflect.TypeOf([]byte{}), types[0].ScanType())
+	assert.NoError(t, rows.Close())
+}
+
+func TestScanExactTypes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	// Columns of the exact types scan into their Go type and into strings
+	tests := []struct {
+		name  string
+		query string
+		text  string
+		value interface {
+			sql.Scanner
+			String() string
+		}
+	}{
+		{name: "DECIMAL", query: "SELECT 12.34::DECIMAL(4, 2)", text: "12.34", value: &Decimal{}},
+		{name: "INTERVAL", query: "SELECT INTERVAL '1 month 2 days 03:04:05'", text: "1 month 2 days 03:04:05", value: &Interval{}},
+		{name: "HUGEINT", query: "SELECT '170141183460469231731687303715884105727'::HUGEINT", text: "170141183460469231731687303715884105727", value: &HugeInt{}},
+		{name: "UHUGEINT", query: "SELECT '340282366920938463463374607431768211455'::UHUGEINT", text: "340282366920938463463374607431768211455", value: &HugeInt{}},
+		{name: "VARINT", query: "SELECT '-123456789012345678901234567890123456789012345678901234567890'::VARINT", text: "-123456789012345678901234567890123456789012345678901234567890", value: &HugeInt{}},
+		{name: "UUID", query: "SELECT 'ffffffff-ffff-ffff-ffff-ffffffffffff'::UUID", text: "ffffffff-ffff-ffff-ffff-ffffffffffff", value: &UUID{}},
+		{name: "BIT", query: "SELECT '0010110'::BIT", text: "0010110", value: &BitString{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var text string
+			err := sqlDB.QueryRow(tt.query).Scan(&text)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.text, text)
+
+			err = sqlDB.QueryRow(tt.query).Scan(tt.value)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.text, tt.value.String())
+
+			rows, err := sqlDB.Query(tt.query)
+			assert.NoError(t, err)
+			types, err := rows.ColumnTypes()
+			assert.NoError(t, err)
+			assert.Equal(t, reflect.TypeOf(tt.value).Elem(), types[0].ScanType())
+			assert.NoError(t, rows.Close())
+		})
+	}
+}
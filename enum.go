@@ -0,0 +1,52 @@
+package pduckdb
+
+import (
+	"database/sql"
+	"strings"
+)
+
+// EnumValues returns the values of an ENUM column, in the order they were declared.
+// It reports false for columns of other types.
+func EnumValues(ct *sql.ColumnType) ([]string, bool) {
+	return parseEnumType(ct.DatabaseTypeName())
+}
+
+// parseEnumType parses the values of an ENUM type name like "ENUM('a', 'b')"
+func parseEnumType(typeName string) ([]string, bool) {
+	list, ok := strings.CutPrefix(typeName, "ENUM(")
+	if !ok {
+		return nil, false
+	}
+
+	values := []string{}
+	for {
+		if rest, ok := strings.CutPrefix(list, ")"); ok {
+			return values, rest == ""
+		}
+		if len(values) > 0 {
+			if list, ok = strings.CutPrefix(list, ", "); !ok {
+				return nil, false
+			}
+		}
+		if list, ok = strings.CutPrefix(list, "'"); !ok {
+			return nil, false
+		}
+
+		// Quotes inside a value are doubled
+		var value strings.Builder
+		for {
+			end := strings.IndexByte(list, '\'')
+			if end < 0 {
+				return nil, false
+			}
+			value.WriteString(list[:end])
+			list = list[end+1:]
+			if !strings.HasPrefix(list, "'") {
+				break
+			}
+			value.WriteByte('\'')
+			list = list[1:]
+		}
+		values = append(values, value.String())
+	}
+}
@@ -0,0 +1,85 @@
+package pduckdb
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEnumType(t *testing.T) {
+	tests := []struct {
+		typeName string
+		expected []string
+		ok       bool
+	}{
+		{"ENUM('active', 'closed')", []string{"active", "closed"}, true},
+		{"ENUM('it''s', 'a, b', '')", []string{"it's", "a, b", ""}, true},
+		{"ENUM()", []string{}, true},
+		{"VARCHAR", nil, false},
+		{"ENUM('unterminated)", nil, false},
+		{"ENUM('a' 'b')", nil, false},
+		{"ENUM('a')x", nil, false},
+	}
+
+	for _, tt := range tests {
+		values, ok := parseEnumType(tt.typeName)
+		assert.Equal(t, tt.ok, ok, tt.typeName)
+		if tt.ok {
+			assert.Equal(t, tt.expected, values, tt.typeName)
+		}
+	}
+}
+
+func TestEnum(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec("CREATE TYPE status AS ENUM ('active', 'suspended', 'it''s closed')")
+	assert.NoError(t, err)
+	_, err = sqlDB.Exec("CREATE TABLE accounts (id INTEGER, status status)")
+	assert.NoError(t, err)
+
+	// ENUM parameters are bound from strings
+	_, err = sqlDB.Exec("INSERT INTO accounts VALUES (1, ?), (2, ?), (3, NULL)", "active", "it's closed")
+	assert.NoError(t, err)
+
+	_, err = sqlDB.Exec("INSERT INTO accounts VALUES (4, ?)", "deleted")
+	assert.ErrorContains(t, err, `invalid ENUM value "deleted"`)
+
+	var status string
+	err = sqlDB.QueryRow("SELECT status FROM accounts WHERE id = 2").Scan(&status)
+	assert.NoError(t, err)
+	assert.Equal(t, "it's closed", status)
+
+	var id int32
+	err = sqlDB.QueryRow("SELECT id FROM accounts WHERE status = ?", "active").Scan(&id)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), id)
+
+	var missing sql.NullString
+	err = sqlDB.QueryRow("SELECT status FROM accounts WHERE id = 3").Scan(&missing)
+	assert.NoError(t, err)
+	assert.False(t, missing.Valid)
+
+	// The dictionary is available from the column type
+	rows, err := sqlDB.Query("SELECT status, id FROM accounts")
+	assert.NoError(t, err)
+	types, err := rows.ColumnTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, "ENUM('active', 'suspended', 'it''s closed')", types[0].DatabaseTypeName())
+	values, ok := EnumValues(types[0])
+	assert.True(t, ok)
+	assert.Equal(t, []string{"active", "suspended", "it's closed"}, values)
+	_, ok = EnumValues(types[1])
+	assert.False(t, ok)
+	assert.Equal(t, "INTEGER", types[1].DatabaseTypeName())
+	assert.NoError(t, rows.Close())
+}
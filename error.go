@@ -1,5 +1,9 @@
 package pduckdb
 
+import (
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+)
+
 // ErrDuckDB represents an error from DuckDB operations
 type ErrDuckDB struct {
 	Message string
@@ -8,3 +12,63 @@ type ErrDuckDB struct {
 func (e ErrDuckDB) Error() string {
 	return e.Message
 }
+
+// Error is returned when DuckDB rejects a statement while preparing or executing it.
+// It carries DuckDB's error classification, the original message and the offending SQL.
+//
+// Use errors.As to inspect it, or errors.Is with a type-only target:
+//
+//	if errors.Is(err, &pduckdb.Error{Type: pduckdb.ErrorTypeConstraint}) {
+//		// handle constraint violation
+//	}
+type Error = duckdb.Error
+
+// ErrorType classifies errors reported by DuckDB
+type ErrorType = duckdb.DuckDBErrorType
+
+// Error types reported by DuckDB
+const (
+	ErrorTypeInvalid              = duckdb.DuckDBErrorInvalid
+	ErrorTypeOutOfRange           = duckdb.DuckDBErrorOutOfRange
+	ErrorTypeConversion           = duckdb.DuckDBErrorConversion
+	ErrorTypeUnknownType          = duckdb.DuckDBErrorUnknownType
+	ErrorTypeDecimal              = duckdb.DuckDBErrorDecimal
+	ErrorTypeMismatchType         = duckdb.DuckDBErrorMismatchType
+	ErrorTypeDivideByZero         = duckdb.DuckDBErrorDivideByZero
+	ErrorTypeObjectSize           = duckdb.DuckDBErrorObjectSize
+	ErrorTypeInvalidType          = duckdb.DuckDBErrorInvalidType
+	ErrorTypeSerialization        = duckdb.DuckDBErrorSerialization
+	ErrorTypeTransaction          = duckdb.DuckDBErrorTransaction
+	ErrorTypeNotImplemented       = duckdb.DuckDBErrorNotImplemented
+	ErrorTypeExpression           = duckdb.DuckDBErrorExpression
+	ErrorTypeCatalog              = duckdb.DuckDBErrorCatalog
+	ErrorTypeParser               = duckdb.DuckDBErrorParser
+	ErrorTypePlanner              = duckdb.DuckDBErrorPlanner
+	ErrorTypeScheduler            = duckdb.DuckDBErrorScheduler
+	ErrorTypeExecutor             = duckdb.DuckDBErrorExecutor
+	ErrorTypeConstraint           = duckdb.DuckDBErrorConstraint
+	ErrorTypeIndex                = duckdb.DuckDBErrorIndex
+	ErrorTypeStat                 = duckdb.DuckDBErrorStat
+	ErrorTypeConnection           = duckdb.DuckDBErrorConnection
+	ErrorTypeSyntax               = duckdb.DuckDBErrorSyntax
+	ErrorTypeSettings             = duckdb.DuckDBErrorSettings
+	ErrorTypeBinder               = duckdb.DuckDBErrorBinder
+	ErrorTypeNetwork              = duckdb.DuckDBErrorNetwork
+	ErrorTypeOptimizer            = duckdb.DuckDBErrorOptimizer
+	ErrorTypeNullPointer          = duckdb.DuckDBErrorNullPointer
+	ErrorTypeIO                   = duckdb.DuckDBErrorIO
+	ErrorTypeInterrupt            = duckdb.DuckDBErrorInterrupt
+	ErrorTypeFatal                = duckdb.DuckDBErrorFatal
+	ErrorTypeInternal             = duckdb.DuckDBErrorInternal
+	ErrorTypeInvalidInput         = duckdb.DuckDBErrorInvalidInput
+	ErrorTypeOutOfMemory          = duckdb.DuckDBErrorOutOfMemory
+	ErrorTypePermission           = duckdb.DuckDBErrorPermission
+	ErrorTypeParameterNotResolved = duckdb.DuckDBErrorParameterNotResolved
+	ErrorTypeParameterNotAllowed  = duckdb.DuckDBErrorParameterNotAllowed
+	ErrorTypeDependency           = duckdb.DuckDBErrorDependency
+	ErrorTypeHTTP                 = duckdb.DuckDBErrorHTTP
+	ErrorTypeMissingExtension     = duckdb.DuckDBErrorMissingExtension
+	ErrorTypeAutoload             = duckdb.DuckDBErrorAutoload
+	ErrorTypeSequence             = duckdb.DuckDBErrorSequence
+	ErrorTypeInvalidConfiguration = duckdb.DuckDBInvalidConfiguration
+)
@@ -1,6 +1,8 @@
 package pduckdb
 
 import (
+	"database/sql"
+	"errors"
 	"testing"
 )
 
@@ -10,3 +12,76 @@ func TestErrDuckDB_Error(t *testing.T) {
 		t.Errorf("ErrDuckDB.Error() = %v, want %v", err.Error(), "test error")
 	}
 }
+
+func TestErrorClassification(t *testing.T) {
+	var err error = &Error{
+		Type:     ErrorTypeConstraint,
+		Message:  "Constraint Error: Duplicate key \"id: 1\" violates primary key constraint.",
+		SQL:      "INSERT INTO t VALUES (1)",
+		Position: -1,
+	}
+
+	if !errors.Is(err, &Error{Type: ErrorTypeConstraint}) {
+		t.Errorf("Expected constraint error to match ErrorTypeConstraint")
+	}
+	if errors.Is(err, &Error{Type: ErrorTypeParser}) {
+		t.Errorf("Expected constraint error not to match ErrorTypeParser")
+	}
+
+	var duckErr *Error
+	if !errors.As(err, &duckErr) || duckErr.Type != ErrorTypeConstraint {
+		t.Errorf("Expected errors.As to find a constraint *Error")
+	}
+	if ErrorTypeConstraint.String() != "Constraint" {
+		t.Errorf("ErrorType.String() = %v, want %v", ErrorTypeConstraint.String(), "Constraint")
+	}
+}
+
+func TestErrorFromDuckDB(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	if err != nil {
+		t.Fatalf("Error opening database: %v", err)
+	}
+	defer func() {
+		_ = sqlDB.Close()
+	}()
+
+	if _, err := sqlDB.Exec("CREATE TABLE t (id INTEGER PRIMARY KEY)"); err != nil {
+		t.Fatalf("Error creating table: %v", err)
+	}
+	if _, err := sqlDB.Exec("INSERT INTO t VALUES (1)"); err != nil {
+		t.Fatalf("Error inserting value: %v", err)
+	}
+
+	// Constraint violation through the prepared statement path
+	_, err = sqlDB.Exec("INSERT INTO t VALUES (?)", 1)
+	if !errors.Is(err, &Error{Type: ErrorTypeConstraint}) {
+		t.Errorf("Expected constraint error, got %v", err)
+	}
+
+	// Syntax error through the direct query path
+	_, err = sqlDB.Exec("SELEC 1")
+	var duckErr *Error
+	if !errors.As(err, &duckErr) {
+		t.Fatalf("Expected *Error, got %T", err)
+	}
+	if duckErr.Type != ErrorTypeParser {
+		t.Errorf("Expected parser error, got %v", duckErr.Type)
+	}
+	if duckErr.SQL != "SELEC 1" {
+		t.Errorf("Expected SQL 'SELEC 1', got %q", duckErr.SQL)
+	}
+	if duckErr.Position != 0 {
+		t.Errorf("Expected position 0, got %d", duckErr.Position)
+	}
+
+	// Catalog error through the prepare path
+	_, err = sqlDB.Query("SELECT * FROM missing WHERE id = ?", 1)
+	if !errors.Is(err, &Error{Type: ErrorTypeCatalog}) {
+		t.Errorf("Expected catalog error, got %v", err)
+	}
+}
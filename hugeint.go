@@ -0,0 +1,10 @@
+package pduckdb
+
+import (
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+)
+
+// HugeInt is an integer of any size. HugeInt implements sql.Scanner to receive
+// HUGEINT, UHUGEINT and VARINT columns exactly, as well as smaller integers and
+// their text representation. A nil Int is zero.
+type HugeInt = duckdb.HugeInt
@@ -0,0 +1,69 @@
+package pduckdb
+
+import (
+	"database/sql"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHugeInt(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec("CREATE TABLE counters (id INTEGER, total HUGEINT, hash UHUGEINT, huge VARINT)")
+	assert.NoError(t, err)
+
+	maxHugeint, _ := new(big.Int).SetString("170141183460469231731687303715884105727", 10)
+	maxUHugeint, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+	huge, _ := new(big.Int).SetString("-123456789012345678901234567890123456789012345678901234567890", 10)
+
+	// Big integers, HugeInt and 64-bit integers are bound exactly
+	_, err = sqlDB.Exec("INSERT INTO counters VALUES (1, ?, ?, ?), (2, ?, ?, ?)",
+		HugeInt{Int: maxHugeint}, HugeInt{Int: maxUHugeint}, HugeInt{Int: huge},
+		int64(-42), uint64(18446744073709551615), "7")
+	assert.NoError(t, err)
+
+	var total, hash, varint HugeInt
+	err = sqlDB.QueryRow("SELECT total, hash, huge FROM counters WHERE id = 1").Scan(&total, &hash, &varint)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, total.Int.Cmp(maxHugeint), "HUGEINT = %s", total)
+	assert.Equal(t, 0, hash.Int.Cmp(maxUHugeint), "UHUGEINT = %s", hash)
+	assert.Equal(t, 0, varint.Int.Cmp(huge), "VARINT = %s", varint)
+
+	// Values that fit are scanned into Go integers
+	var small int64
+	var unsigned uint64
+	var n float64
+	err = sqlDB.QueryRow("SELECT total, hash, huge FROM counters WHERE id = 2").Scan(&small, &unsigned, &n)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-42), small)
+	assert.Equal(t, uint64(18446744073709551615), unsigned)
+	assert.Equal(t, float64(7), n)
+
+	var id int32
+	err = sqlDB.QueryRow("SELECT id FROM counters WHERE total = ?", HugeInt{Int: maxHugeint}).Scan(&id)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), id)
+
+	_, err = sqlDB.Exec("INSERT INTO counters (hash) VALUES (?)", int64(-1))
+	assert.Error(t, err, "UHUGEINT is unsigned")
+
+	rows, err := sqlDB.Query("SELECT total, hash, huge FROM counters")
+	assert.NoError(t, err)
+	types, err := rows.ColumnTypes()
+	assert.NoError(t, err)
+	for _, ct := range types {
+		assert.Equal(t, reflect.TypeOf(HugeInt{}), ct.ScanType())
+	}
+	assert.NoError(t, rows.Close())
+}
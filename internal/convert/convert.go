@@ -2,6 +2,8 @@
 package convert
 
 import (
+	"math"
+	"strings"
 	"time"
 )
 
@@ -10,8 +12,15 @@ type Date struct {
 	Days int32
 }
 
-// ToTime converts a DuckDB Date to a Go time.Time
+// ToTime converts a DuckDB Date to a Go time.Time.
+// Infinite dates convert to InfinityTime and NegativeInfinityTime.
 func (d Date) ToTime() time.Time {
+	switch d.Days {
+	case DateInfinity:
+		return InfinityTime
+	case DateNegativeInfinity:
+		return NegativeInfinityTime
+	}
 	epoch := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
 	return epoch.AddDate(0, 0, int(d.Days))
 }
@@ -31,3 +40,43 @@ type (
 	DuckDBDate = int32
 	DuckDBTime = int64
 )
+
+// DuckDB stores infinite dates and timestamps as the largest and smallest finite values
+const (
+	DateInfinity              DuckDBDate = math.MaxInt32
+	DateNegativeInfinity      DuckDBDate = -math.MaxInt32
+	TimestampInfinity                    = math.MaxInt64
+	TimestampNegativeInfinity            = -math.MaxInt64
+)
+
+// InfinityTime and NegativeInfinityTime represent infinite dates and timestamps.
+// They lie billions of years beyond the range of DuckDB's finite values,
+// so that they sort after and before every finite time.
+var (
+	InfinityTime         = time.Unix(1<<62, 0).UTC()
+	NegativeInfinityTime = time.Unix(-1<<62, 0).UTC()
+)
+
+// Infinity reports whether t is InfinityTime (1) or NegativeInfinityTime (-1), or finite (0)
+func Infinity(t time.Time) int {
+	switch {
+	case t.Equal(InfinityTime):
+		return 1
+	case t.Equal(NegativeInfinityTime):
+		return -1
+	default:
+		return 0
+	}
+}
+
+// parseInfinity parses DuckDB's text for an infinite date or timestamp
+func parseInfinity(s string) (time.Time, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "infinity", "+infinity":
+		return InfinityTime, true
+	case "-infinity":
+		return NegativeInfinityTime, true
+	default:
+		return time.Time{}, false
+	}
+}
@@ -233,6 +233,55 @@ func TestToDate(t *testing.T) {
 			expected: Date{Days: 19358},
 			wantErr:  false,
 		},
+		{
+			name:  "convert time.Time before the epoch",
+			input: time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC),
+			// The date of a time is the day it falls on, not the nearest day
+			expected: Date{Days: -1},
+			wantErr:  false,
+		},
+		{
+			name:     "convert time.Time in year 1",
+			input:    time.Date(1, 1, 1, 12, 0, 0, 0, time.UTC),
+			expected: Date{Days: -719162},
+			wantErr:  false,
+		},
+		{
+			name:     "convert string in year 1",
+			input:    "0001-01-01",
+			expected: Date{Days: -719162},
+			wantErr:  false,
+		},
+		{
+			name:     "convert time.Time in year 9999",
+			input:    time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+			expected: Date{Days: 2932896},
+			wantErr:  false,
+		},
+		{
+			name:     "convert string in year 9999",
+			input:    "9999-12-31",
+			expected: Date{Days: 2932896},
+			wantErr:  false,
+		},
+		{
+			name:     "convert time.Time out of range",
+			input:    time.Date(6000000, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: Date{},
+			wantErr:  true,
+		},
+		{
+			name:     "convert infinity",
+			input:    InfinityTime,
+			expected: Date{Days: DateInfinity},
+			wantErr:  false,
+		},
+		{
+			name:     "convert string -infinity",
+			input:    "-infinity",
+			expected: Date{Days: DateNegativeInfinity},
+			wantErr:  false,
+		},
 		{
 			name:     "convert string invalid",
 			input:    "not a date",
@@ -354,6 +403,18 @@ func TestToTimestamp(t *testing.T) {
 			expected: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
 			wantErr:  false,
 		},
+		{
+			name:     "convert string infinity",
+			input:    "Infinity",
+			expected: InfinityTime,
+			wantErr:  false,
+		},
+		{
+			name:     "convert string -infinity",
+			input:    "-infinity",
+			expected: NegativeInfinityTime,
+			wantErr:  false,
+		},
 		{
 			name:     "convert string invalid",
 			input:    "not a timestamp",
@@ -13,31 +13,54 @@ func ToDate(value any) (Date, error) {
 	case DuckDBDate:
 		return Date{Days: int32(v)}, nil
 	case time.Time:
-		// Convert to UTC first to ensure consistent behavior
-		utc := v.UTC()
-		// Get the date part only (zero out the time component)
-		date := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
-		// Calculate days since epoch (1970-01-01)
-		epoch := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-		days := int32(date.Sub(epoch).Hours() / 24)
+		switch Infinity(v) {
+		case 1:
+			return Date{Days: DateInfinity}, nil
+		case -1:
+			return Date{Days: DateNegativeInfinity}, nil
+		}
+		days, err := epochDays(v)
+		if err != nil {
+			return Date{}, err
+		}
 		return Date{Days: days}, nil
 	case string:
+		if t, ok := parseInfinity(v); ok {
+			return ToDate(t)
+		}
 		// Try to parse as ISO date
 		t, err := time.Parse("2006-01-02", v)
 		if err != nil {
 			return Date{}, fmt.Errorf("cannot parse string '%s' as date: %v", v, err)
 		}
-		// Convert to UTC first to ensure consistent behavior
-		utc := t.UTC()
-		// Calculate days since epoch (1970-01-01)
-		epoch := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-		days := int32(utc.Sub(epoch).Hours() / 24)
+		days, err := epochDays(t)
+		if err != nil {
+			return Date{}, err
+		}
 		return Date{Days: days}, nil
 	default:
 		return Date{}, fmt.Errorf("cannot convert %T to Date", value)
 	}
 }
 
+const secondsPerDay = 24 * 60 * 60
+
+// epochDays returns the number of days from 1970-01-01 to the UTC date of t.
+// time.Duration only spans about 292 years, so the days are computed from
+// Unix seconds, rounding down for times before the epoch.
+func epochDays(t time.Time) (int32, error) {
+	seconds := t.Unix()
+	days := seconds / secondsPerDay
+	if seconds%secondsPerDay < 0 {
+		days--
+	}
+	// The largest and smallest int32 are reserved for the infinite dates
+	if days <= int64(DateNegativeInfinity) || days >= int64(DateInfinity) {
+		return 0, fmt.Errorf("date %s is out of range", t.UTC().Format("2006-01-02"))
+	}
+	return int32(days), nil
+}
+
 // ToTime converts a value to a Time
 func ToTime(value any) (Time, error) {
 	switch v := value.(type) {
@@ -87,6 +110,9 @@ func ToTimestamp(value any) (time.Time, error) {
 	case time.Time:
 		return v, nil
 	case string:
+		if t, ok := parseInfinity(v); ok {
+			return t, nil
+		}
 		// Try several common timestamp formats
 		formats := []string{
 			"2006-01-02 15:04:05.999999",
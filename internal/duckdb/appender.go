@@ -0,0 +1,263 @@
+package duckdb
+
+import (
+	"fmt"
+	"time"
+	"unsafe"
+
+	"github.com/fpt/go-pduckdb/internal/convert"
+	"github.com/pkg/errors"
+)
+
+// Appender loads rows into a table through a DuckDB appender
+type Appender struct {
+	db     *DB
+	handle DuckDBAppender
+	table  string
+	// Column types of the table, in column order
+	types        []DuckDBType
+	logicalTypes []DuckDBLogicalType
+	// Converted values of the row being appended
+	row []any
+	// Set when DuckDB rejected a value after part of a row was appended
+	failed error
+}
+
+// NewAppender creates an appender for the given table.
+// An empty schema selects the default schema.
+func (c *Connection) NewAppender(schema, table string) (*Appender, error) {
+	if c.db.AppenderCreate == nil {
+		return nil, fmt.Errorf("appender functions not available in this DuckDB build")
+	}
+
+	var cSchema *byte
+	if schema != "" {
+		cSchema = ToCString(schema)
+		defer FreeCString(cSchema)
+	}
+	cTable := ToCString(table)
+	defer FreeCString(cTable)
+
+	var handle DuckDBAppender
+	state := c.db.AppenderCreate(c.handle, cSchema, cTable, &handle)
+	a := &Appender{
+		db:     c.db,
+		handle: handle,
+		table:  table,
+	}
+	if state != DuckDBSuccess {
+		// The appender carries the error and must be destroyed even on failure
+		err := a.error("failed to create appender")
+		c.db.AppenderDestroy(&a.handle)
+		return nil, err
+	}
+
+	count := c.db.AppenderColumnCount(handle)
+	a.types = make([]DuckDBType, count)
+	a.logicalTypes = make([]DuckDBLogicalType, count)
+	for i := range a.types {
+		// The logical types are kept to validate ENUM values, and destroyed on Close
+		a.logicalTypes[i] = c.db.AppenderColumnType(handle, int64(i))
+		a.types[i] = c.db.GetTypeID(a.logicalTypes[i])
+	}
+	a.row = make([]any, count)
+
+	return a, nil
+}
+
+// ColumnCount returns the number of columns of the appended table
+func (a *Appender) ColumnCount() int {
+	return len(a.types)
+}
+
+// AppendRow appends one row with a value for every column of the table.
+// Values are converted to the column types and validated before anything is
+// appended, so a value that cannot be converted leaves the appender untouched.
+//
+// DuckDB cannot take back the values of a partly appended row. If it still
+// rejects a value, like a malformed LIST text, the appender refuses further rows.
+func (a *Appender) AppendRow(values []any) error {
+	if a.handle == nil {
+		return fmt.Errorf("appender is closed")
+	}
+	if a.failed != nil {
+		return a.failed
+	}
+	if len(values) != len(a.types) {
+		return fmt.Errorf("expected %d values for table %s, got %d", len(a.types), a.table, len(values))
+	}
+
+	for i, value := range values {
+		converted, err := appendValue(a.db, value, a.logicalTypes[i], a.types[i])
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert value of column %d", i)
+		}
+		a.row[i] = converted
+	}
+
+	for i, value := range a.row {
+		if state := a.append(value); state != DuckDBSuccess {
+			err := a.error(fmt.Sprintf("failed to append value of column %d", i))
+			if i > 0 {
+				a.failed = errors.Wrap(err, "appender has an incomplete row")
+			}
+			return err
+		}
+	}
+
+	if state := a.db.AppenderEndRow(a.handle); state != DuckDBSuccess {
+		return a.error("failed to end row")
+	}
+
+	return nil
+}
+
+// Flush writes the appended rows to the table.
+// Constraint violations are reported when the rows are flushed.
+func (a *Appender) Flush() error {
+	if a.handle == nil {
+		return fmt.Errorf("appender is closed")
+	}
+	if a.failed != nil {
+		return a.failed
+	}
+
+	if state := a.db.AppenderFlush(a.handle); state != DuckDBSuccess {
+		return a.error("failed to flush appender")
+	}
+
+	return nil
+}
+
+// Close flushes the remaining rows and destroys the appender
+func (a *Appender) Close() error {
+	if a.handle == nil {
+		return nil
+	}
+
+	var err error
+	if state := a.db.AppenderClose(a.handle); state != DuckDBSuccess {
+		err = a.error("failed to close appender")
+	}
+	a.db.AppenderDestroy(&a.handle)
+	a.handle = nil
+	for i := range a.logicalTypes {
+		a.db.DestroyLogicalType(&a.logicalTypes[i])
+	}
+
+	return err
+}
+
+// error returns the last error of the appender, or fallback if there is none
+func (a *Appender) error(fallback string) *Error {
+	message := fallback
+	if a.db.AppenderError != nil {
+		if msg := GoString(a.db.AppenderError(a.handle)); msg != "" {
+			message = msg
+		}
+	}
+
+	return newError(DuckDBErrorInvalid, message, "")
+}
+
+// append appends one value converted by appendValue
+func (a *Appender) append(value any) DuckDBState {
+	db := a.db
+	switch v := value.(type) {
+	case nil:
+		return db.AppendNull(a.handle)
+	case bool:
+		return db.AppendBool(a.handle, v)
+	case int8:
+		return db.AppendInt8(a.handle, v)
+	case int16:
+		return db.AppendInt16(a.handle, v)
+	case int32:
+		return db.AppendInt32(a.handle, v)
+	case int64:
+		return db.AppendInt64(a.handle, v)
+	case uint8:
+		return db.AppendUint8(a.handle, v)
+	case uint16:
+		return db.AppendUint16(a.handle, v)
+	case uint32:
+		return db.AppendUint32(a.handle, v)
+	case uint64:
+		return db.AppendUint64(a.handle, v)
+	case float32:
+		return db.AppendFloat(a.handle, v)
+	case float64:
+		return db.AppendDouble(a.handle, v)
+	case convert.Date:
+		return db.AppendDate(a.handle, v.Days)
+	case convert.Time:
+		return db.AppendTime(a.handle, v.Micros)
+	case time.Time:
+		return db.AppendTimestamp(a.handle, timestampUnits(v, DuckDBTypeTimestamp))
+	case string:
+		return db.AppendVarcharLength(a.handle, dataPointer(unsafe.StringData(v)), int64(len(v)))
+	case []byte:
+		return db.AppendBlob(a.handle, unsafe.Pointer(dataPointer(unsafe.SliceData(v))), int64(len(v)))
+	default:
+		return DuckDBError
+	}
+}
+
+// emptyData backs empty strings and blobs, which have no data pointer of their own
+var emptyData byte
+
+// dataPointer returns p, or a valid pointer for empty data if p is nil
+func dataPointer(p *byte) *byte {
+	if p == nil {
+		return &emptyData
+	}
+	return p
+}
+
+// appendValue converts a Go value to the Go type appended for a column of the given type.
+// Types without a dedicated append function are appended as text, which DuckDB casts
+// to the column type. The text of scalar types is validated here, so that DuckDB does
+// not reject it after part of the row was appended.
+func appendValue(db *DB, value any, logicalType DuckDBLogicalType, columnType DuckDBType) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch columnType {
+	case DuckDBTypeBoolean:
+		return convert.ToBoolean(value)
+	case DuckDBTypeTinyint:
+		return convert.ToInt8(value)
+	case DuckDBTypeSmallint:
+		return convert.ToInt16(value)
+	case DuckDBTypeInteger:
+		return convert.ToInt32(value)
+	case DuckDBTypeBigint:
+		return convert.ToInt64(value)
+	case DuckDBTypeUTinyint:
+		return convert.ToUint8(value)
+	case DuckDBTypeUSmallint:
+		return convert.ToUint16(value)
+	case DuckDBTypeUInteger:
+		return convert.ToUint32(value)
+	case DuckDBTypeUBigint:
+		return convert.ToUint64(value)
+	case DuckDBTypeFloat:
+		return convert.ToFloat32(value)
+	case DuckDBTypeDouble:
+		return convert.ToFloat64(value)
+	case DuckDBTypeDate:
+		return convert.ToDate(value)
+	case DuckDBTypeTime:
+		return convert.ToTime(value)
+	case DuckDBTypeTimestamp:
+		return convert.ToTimestamp(value)
+	case DuckDBTypeBlob:
+		return toBlob(value)
+	case DuckDBTypeList, DuckDBTypeStruct, DuckDBTypeMap, DuckDBTypeArray, DuckDBTypeUnion:
+		// Nested values are only validated by DuckDB's cast
+		return convert.ToString(value)
+	default:
+		return db.valueText(value, logicalType, columnType)
+	}
+}
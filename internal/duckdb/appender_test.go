@@ -0,0 +1,201 @@
+package duckdb
+
+import (
+	"math"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/fpt/go-pduckdb/internal/convert"
+)
+
+// testAppender creates an appender for a mock table with the given column types.
+// Appended values are recorded in the returned slice, with "END" marking the end of a row.
+func testAppender(t *testing.T, types ...DuckDBType) (*Appender, *[]any) {
+	t.Helper()
+	conn := testConnection()
+	db := conn.db
+
+	var appended []any
+	record := func(v any) DuckDBState {
+		appended = append(appended, v)
+		return DuckDBSuccess
+	}
+
+	db.AppenderCreate = func(_ DuckDBConnection, _ *byte, _ *byte, out *DuckDBAppender) DuckDBState {
+		*out = DuckDBAppender(&emptyData)
+		return DuckDBSuccess
+	}
+	db.AppenderColumnCount = func(DuckDBAppender) int64 { return int64(len(types)) }
+	db.AppenderColumnType = func(_ DuckDBAppender, col int64) DuckDBLogicalType {
+		return DuckDBLogicalType(&types[col])
+	}
+	db.GetTypeID = func(lt DuckDBLogicalType) DuckDBType { return *(*DuckDBType)(lt) }
+	db.DestroyLogicalType = func(*DuckDBLogicalType) {}
+	db.AppenderEndRow = func(DuckDBAppender) DuckDBState { return record("END") }
+	db.AppendNull = func(DuckDBAppender) DuckDBState { return record(nil) }
+	db.AppendBool = func(_ DuckDBAppender, v bool) DuckDBState { return record(v) }
+	db.AppendInt32 = func(_ DuckDBAppender, v int32) DuckDBState { return record(v) }
+	db.AppendInt64 = func(_ DuckDBAppender, v int64) DuckDBState { return record(v) }
+	db.AppendDouble = func(_ DuckDBAppender, v float64) DuckDBState { return record(v) }
+	db.AppendDate = func(_ DuckDBAppender, v int32) DuckDBState { return record(v) }
+	db.AppendTimestamp = func(_ DuckDBAppender, v int64) DuckDBState { return record(v) }
+	db.AppendVarcharLength = func(_ DuckDBAppender, v *byte, n int64) DuckDBState {
+		return record(string(unsafe.Slice(v, n)))
+	}
+
+	appender, err := conn.NewAppender("", "events")
+	if err != nil {
+		t.Fatalf("Expected appender, got error: %v", err)
+	}
+	return appender, &appended
+}
+
+func TestAppenderAppendRow(t *testing.T) {
+	appender, appended := testAppender(t,
+		DuckDBTypeInteger, DuckDBTypeVarchar, DuckDBTypeDate, DuckDBTypeTimestamp, DuckDBTypeDecimal)
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err := appender.AppendRow([]any{42, "event", "1970-01-11", ts, 1.5}); err != nil {
+		t.Fatalf("Expected successful append, got error: %v", err)
+	}
+	if err := appender.AppendRow([]any{nil, "", nil, nil, nil}); err != nil {
+		t.Fatalf("Expected successful append, got error: %v", err)
+	}
+
+	expected := []any{
+		int32(42), "event", int32(10), ts.UnixMicro(), "1.5", "END",
+		nil, "", nil, nil, nil, "END",
+	}
+	if len(*appended) != len(expected) {
+		t.Fatalf("Expected %d appended values, got %v", len(expected), *appended)
+	}
+	for i, v := range expected {
+		if (*appended)[i] != v {
+			t.Errorf("Value %d: expected %v (%T), got %v (%T)", i, v, v, (*appended)[i], (*appended)[i])
+		}
+	}
+}
+
+func TestAppenderAppendInfinity(t *testing.T) {
+	appender, appended := testAppender(t, DuckDBTypeDate, DuckDBTypeTimestamp, DuckDBTypeTimestampTZ)
+
+	if err := appender.AppendRow([]any{convert.NegativeInfinityTime, convert.InfinityTime, convert.InfinityTime}); err != nil {
+		t.Fatalf("Expected successful append, got error: %v", err)
+	}
+
+	expected := []any{int32(-math.MaxInt32), int64(math.MaxInt64), "infinity", "END"}
+	for i, v := range expected {
+		if (*appended)[i] != v {
+			t.Errorf("Value %d: expected %v (%T), got %v (%T)", i, v, v, (*appended)[i], (*appended)[i])
+		}
+	}
+}
+
+func TestAppenderAppendRowErrors(t *testing.T) {
+	appender, appended := testAppender(t, DuckDBTypeInteger, DuckDBTypeVarchar)
+
+	tests := []struct {
+		name   string
+		values []any
+	}{
+		{"too few values", []any{1}},
+		{"too many values", []any{1, "a", "b"}},
+		{"out of range", []any{int64(1) << 40, "a"}},
+		{"not convertible", []any{"not a number", "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := appender.AppendRow(tt.values); err == nil {
+				t.Errorf("Expected error for %v", tt.values)
+			}
+		})
+	}
+
+	// Values are validated before anything is appended
+	if len(*appended) != 0 {
+		t.Errorf("Expected nothing appended, got %v", *appended)
+	}
+}
+
+func TestAppenderValidatesText(t *testing.T) {
+	appender, appended := testAppender(t, DuckDBTypeInteger, DuckDBTypeDecimal, DuckDBTypeUUID)
+
+	tests := []struct {
+		name   string
+		values []any
+	}{
+		{"decimal", []any{1, "not a decimal", "0e9d1b7c-4e4d-4a38-9f2c-2c3d7a1b9e00"}},
+		{"uuid", []any{1, "1.5", "not a uuid"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := appender.AppendRow(tt.values); err == nil {
+				t.Errorf("Expected error for %v", tt.values)
+			}
+		})
+	}
+
+	// Invalid text is rejected before the valid columns are appended
+	if len(*appended) != 0 {
+		t.Errorf("Expected nothing appended, got %v", *appended)
+	}
+}
+
+func TestAppenderIncompleteRow(t *testing.T) {
+	appender, appended := testAppender(t, DuckDBTypeInteger, DuckDBTypeList)
+
+	// DuckDB rejects the LIST text after the first column was appended
+	appender.db.AppendVarcharLength = func(DuckDBAppender, *byte, int64) DuckDBState {
+		return DuckDBError
+	}
+	appender.db.AppenderError = func(DuckDBAppender) *byte { return nil }
+
+	if err := appender.AppendRow([]any{1, "[1, 2"}); err == nil {
+		t.Fatalf("Expected error for malformed list")
+	}
+	if len(*appended) != 1 {
+		t.Fatalf("Expected the first column to be appended, got %v", *appended)
+	}
+
+	// The incomplete row cannot be taken back, so further rows are refused
+	if err := appender.AppendRow([]any{2, "[3]"}); err == nil {
+		t.Errorf("Expected error appending after an incomplete row")
+	}
+	if err := appender.Flush(); err == nil {
+		t.Errorf("Expected error flushing an incomplete row")
+	}
+	if len(*appended) != 1 {
+		t.Errorf("Expected nothing more appended, got %v", *appended)
+	}
+}
+
+func TestAppenderClosed(t *testing.T) {
+	appender, _ := testAppender(t, DuckDBTypeInteger)
+
+	var closed, destroyed bool
+	appender.db.AppenderClose = func(DuckDBAppender) DuckDBState {
+		closed = true
+		return DuckDBSuccess
+	}
+	appender.db.AppenderDestroy = func(*DuckDBAppender) DuckDBState {
+		destroyed = true
+		return DuckDBSuccess
+	}
+
+	if err := appender.Close(); err != nil {
+		t.Fatalf("Expected successful close, got error: %v", err)
+	}
+	if !closed || !destroyed {
+		t.Errorf("Expected appender to be closed and destroyed")
+	}
+
+	if err := appender.AppendRow([]any{1}); err == nil {
+		t.Errorf("Expected error appending to a closed appender")
+	}
+	if err := appender.Close(); err != nil {
+		t.Errorf("Expected closing twice to succeed, got error: %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+package duckdb
+
+import (
+	"slices"
+	"unsafe"
+)
+
+// arrayValue decodes the ARRAY at the given row into a slice.
+// Arrays of FLOAT and DOUBLE without NULL elements, such as embeddings,
+// are copied from the child vector in one pass.
+func (v *Vector) arrayValue(row int) any {
+	size := v.reader.arraySize
+	offset := row * size
+	child := v.children[0]
+	if child.allValid(offset, size) {
+		switch child.reader.typeID {
+		case DuckDBTypeFloat:
+			return copyData[float32](child.data, offset, size)
+		case DuckDBTypeDouble:
+			return copyData[float64](child.data, offset, size)
+		}
+	}
+	return v.listValue(offset, size)
+}
+
+// allValid reports whether none of length values starting at offset are NULL.
+// Entries of the validity mask that cover 64 rows of the range are checked at once.
+func (v *Vector) allValid(offset, length int) bool {
+	if v.validity == nil {
+		return true
+	}
+	end := offset + length
+	for row := offset; row < end; {
+		if row%64 == 0 && row+64 <= end {
+			entry := *(*uint64)(unsafe.Add(unsafe.Pointer(v.validity), row/64*8))
+			if entry != ^uint64(0) {
+				return false
+			}
+			row += 64
+			continue
+		}
+		if v.IsNull(row) {
+			return false
+		}
+		row++
+	}
+	return true
+}
+
+// copyData copies length fixed size elements starting at offset out of vector data
+func copyData[T any](data unsafe.Pointer, offset, length int) []T {
+	var zero T
+	return slices.Clone(unsafe.Slice((*T)(unsafe.Add(data, uintptr(offset)*unsafe.Sizeof(zero))), length))
+}
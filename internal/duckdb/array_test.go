@@ -0,0 +1,78 @@
+package duckdb
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testArrayVector creates an ARRAY vector of the given size over a child vector
+func testArrayVector(size int, child *Vector) *Vector {
+	v := &Vector{reader: &ValueReader{typeID: DuckDBTypeArray, arraySize: size}, children: []*Vector{child}}
+	v.reader.children = []*ValueReader{child.reader}
+	return v
+}
+
+func TestVectorArray(t *testing.T) {
+	floats := []float32{0.25, 0.5, 1, 2}
+	v := testArrayVector(2, testVector(DuckDBTypeFloat, floats, nil))
+
+	got, ok := v.Value(1).([]float32)
+	if !ok || !reflect.DeepEqual(got, []float32{1, 2}) {
+		t.Fatalf("Value(1) = %#v, want []float32{1, 2}", v.Value(1))
+	}
+	// The value is a copy that outlives the vector
+	got[0] = 3
+	if floats[2] != 1 {
+		t.Errorf("Value(1) shares memory with the vector")
+	}
+	if got := v.text(0); got != "[0.25, 0.5]" {
+		t.Errorf("text(0) = %v, want [0.25, 0.5]", got)
+	}
+	if got := v.reader.TypeName(); got != "FLOAT[2]" {
+		t.Errorf("TypeName() = %v, want FLOAT[2]", got)
+	}
+	if got := v.reader.GoType(); got != reflect.TypeOf([]float32{}) {
+		t.Errorf("GoType() = %v, want []float32", got)
+	}
+
+	// Arrays with NULL elements and of other types are decoded element by element
+	doubles := testArrayVector(2, testVector(DuckDBTypeDouble, []float64{1.5, 0, 2.5, 3.5}, []uint64{0b1101}))
+	if got := doubles.Value(0); !reflect.DeepEqual(got, []any{1.5, nil}) {
+		t.Errorf("Value(0) = %#v, want []any{1.5, nil}", got)
+	}
+	if got := doubles.Value(1); !reflect.DeepEqual(got, []float64{2.5, 3.5}) {
+		t.Errorf("Value(1) = %#v, want []float64{2.5, 3.5}", got)
+	}
+	ints := testArrayVector(3, testVector(DuckDBTypeInteger, []int32{1, 2, 3}, nil))
+	if got := ints.Value(0); !reflect.DeepEqual(got, []int32{1, 2, 3}) {
+		t.Errorf("Value(0) = %#v, want []int32{1, 2, 3}", got)
+	}
+}
+
+func TestVectorAllValid(t *testing.T) {
+	// Two 64-row entries of the validity mask, and the first bit of a third
+	v := testVector(DuckDBTypeDouble, make([]float64, 130), []uint64{^uint64(0), ^uint64(0), 1})
+	if !v.allValid(0, 129) || !v.allValid(3, 100) {
+		t.Errorf("allValid() = false for valid rows")
+	}
+	if v.allValid(60, 70) {
+		t.Errorf("allValid(60, 70) = true, want false for the NULL row 129")
+	}
+
+	*v.validity &^= 1 << 5
+	if v.allValid(0, 64) || v.allValid(5, 1) {
+		t.Errorf("allValid() = true, want false for the NULL row 5")
+	}
+	if !v.allValid(6, 100) {
+		t.Errorf("allValid(6, 100) = false for valid rows")
+	}
+
+	// Embeddings are copied when the mask holds no NULL, and keep NULL elements otherwise
+	embeddings := testArrayVector(64, v)
+	if got, ok := embeddings.Value(1).([]float64); !ok || len(got) != 64 {
+		t.Errorf("Value(1) = %#v, want a []float64 of 64 values", embeddings.Value(1))
+	}
+	if got, ok := embeddings.Value(0).([]any); !ok || got[5] != nil {
+		t.Errorf("Value(0) = %#v, want a []any with nil at 5", embeddings.Value(0))
+	}
+}
@@ -0,0 +1,194 @@
+package duckdb
+
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	bigIntType  = reflect.TypeOf((*big.Int)(nil))
+)
+
+// assign converts a decoded DuckDB value to the target type, following the rules
+// database/sql uses to scan a column: numbers and text convert between each other
+// when the value fits, sql.Scanner implementations receive the value,
+// slices and maps convert element by element, and STRUCT values fill structs.
+// Pointers receive a converted copy, so that NULL elements can be told apart.
+// NULL converts to the zero value of pointers, interfaces, slices and maps only.
+func assign(value any, target reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		switch target.Kind() {
+		case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+			return reflect.Zero(target), nil
+		default:
+			return reflect.Value{}, errors.Errorf("cannot convert NULL to %s", target)
+		}
+	}
+
+	src := reflect.ValueOf(value)
+	if src.Type().AssignableTo(target) {
+		return src, nil
+	}
+	if reflect.PointerTo(target).Implements(scannerType) {
+		out := reflect.New(target)
+		if err := out.Interface().(sql.Scanner).Scan(value); err != nil {
+			return reflect.Value{}, err
+		}
+		return out.Elem(), nil
+	}
+	if src.Kind() == target.Kind() && src.Type().ConvertibleTo(target) && target.Kind() != reflect.Slice {
+		// Named types such as type Tag string
+		return src.Convert(target), nil
+	}
+	if target == bigIntType {
+		n, err := toBigInt(value)
+		return reflect.ValueOf(n), err
+	}
+	if target.Kind() == reflect.Pointer {
+		elem, err := assign(value, target.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		out := reflect.New(target.Elem())
+		out.Elem().Set(elem)
+		return out, nil
+	}
+
+	out := reflect.New(target).Elem()
+	switch target.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(numberText(value), 10, target.Bits())
+		if err != nil {
+			return reflect.Value{}, errors.Errorf("cannot convert %v (%T) to %s", value, value, target)
+		}
+		out.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(numberText(value), 10, target.Bits())
+		if err != nil {
+			return reflect.Value{}, errors.Errorf("cannot convert %v (%T) to %s", value, value, target)
+		}
+		out.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(numberText(value), target.Bits())
+		if err != nil {
+			return reflect.Value{}, errors.Errorf("cannot convert %v (%T) to %s", value, value, target)
+		}
+		out.SetFloat(f)
+	case reflect.String:
+		switch v := value.(type) {
+		case []byte:
+			out.SetString(string(v))
+		case fmt.Stringer:
+			out.SetString(v.String())
+		default:
+			if !isNumber(src.Kind()) {
+				return reflect.Value{}, errors.Errorf("cannot convert %T to %s", value, target)
+			}
+			out.SetString(fmt.Sprint(value))
+		}
+	case reflect.Bool:
+		s, ok := value.(string)
+		if !ok {
+			return reflect.Value{}, errors.Errorf("cannot convert %T to %s", value, target)
+		}
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return reflect.Value{}, errors.Errorf("cannot convert %q to %s", s, target)
+		}
+		out.SetBool(b)
+	case reflect.Slice:
+		if s, ok := value.(string); ok && target.Elem().Kind() == reflect.Uint8 {
+			out.SetBytes([]byte(s))
+			break
+		}
+		if src.Kind() != reflect.Slice {
+			return reflect.Value{}, errors.Errorf("cannot convert %T to %s", value, target)
+		}
+		out = reflect.MakeSlice(target, src.Len(), src.Len())
+		for i := 0; i < src.Len(); i++ {
+			elem, err := assign(src.Index(i).Interface(), target.Elem())
+			if err != nil {
+				return reflect.Value{}, errors.Wrapf(err, "element %d", i)
+			}
+			out.Index(i).Set(elem)
+		}
+	case reflect.Map:
+		if entries, ok := value.([]MapEntry); ok {
+			return assignEntries(entries, target)
+		}
+		if src.Kind() != reflect.Map {
+			return reflect.Value{}, errors.Errorf("cannot convert %T to %s", value, target)
+		}
+		out = reflect.MakeMapWithSize(target, src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			key, err := assign(iter.Key().Interface(), target.Key())
+			if err != nil {
+				return reflect.Value{}, errors.Wrapf(err, "key %v", iter.Key())
+			}
+			elem, err := assign(iter.Value().Interface(), target.Elem())
+			if err != nil {
+				return reflect.Value{}, errors.Wrapf(err, "value of key %v", iter.Key())
+			}
+			out.SetMapIndex(key, elem)
+		}
+	case reflect.Struct:
+		children, ok := value.(map[string]any)
+		if !ok {
+			return reflect.Value{}, errors.Errorf("cannot convert %T to %s", value, target)
+		}
+		return assignStruct(children, target)
+	default:
+		return reflect.Value{}, errors.Errorf("cannot convert %T to %s", value, target)
+	}
+	return out, nil
+}
+
+// numberText returns the text of a number, or of text that may hold one
+func numberText(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// isNumber reports whether values of the kind are integers or floats
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// assignEntries converts MAP entries to a map of the target type
+func assignEntries(entries []MapEntry, target reflect.Type) (reflect.Value, error) {
+	out := reflect.MakeMapWithSize(target, len(entries))
+	for _, entry := range entries {
+		key, err := assign(entry.Key, target.Key())
+		if err != nil {
+			return reflect.Value{}, errors.Wrapf(err, "key %v", entry.Key)
+		}
+		elem, err := assign(entry.Value, target.Elem())
+		if err != nil {
+			return reflect.Value{}, errors.Wrapf(err, "value of key %v", entry.Key)
+		}
+		out.SetMapIndex(key, elem)
+	}
+	return out, nil
+}
@@ -0,0 +1,171 @@
+package duckdb
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// BitString is a DuckDB BIT value: a string of bits of any length.
+// Bit 0 is the first, leftmost bit of the string.
+// The zero BitString is empty.
+type BitString struct {
+	data   []byte
+	length int
+}
+
+// NewBitString returns a BitString of length bits, all 0
+func NewBitString(length int) BitString {
+	return BitString{data: make([]byte, (length+7)/8), length: length}
+}
+
+// BitStringFromBytes returns a BitString of the bits of b, most significant bit first
+func BitStringFromBytes(b []byte) BitString {
+	return BitString{data: append([]byte(nil), b...), length: len(b) * 8}
+}
+
+// ParseBitString parses a string of 0s and 1s like "10110"
+func ParseBitString(s string) (BitString, error) {
+	b := NewBitString(len(s))
+	for i, c := range s {
+		switch c {
+		case '0':
+		case '1':
+			b.Set(i, true)
+		default:
+			return BitString{}, errors.Errorf("invalid BIT %q, expected only 0 and 1", s)
+		}
+	}
+	return b, nil
+}
+
+// Len returns the number of bits
+func (b BitString) Len() int {
+	return b.length
+}
+
+// Test reports whether bit i is 1. It panics if i is out of range.
+func (b BitString) Test(i int) bool {
+	b.check(i)
+	return b.data[i/8]&(0x80>>(i%8)) != 0
+}
+
+// Set sets bit i to 1 if on is true and to 0 otherwise. It panics if i is out of range.
+func (b *BitString) Set(i int, on bool) {
+	b.check(i)
+	if on {
+		b.data[i/8] |= 0x80 >> (i % 8)
+	} else {
+		b.data[i/8] &^= 0x80 >> (i % 8)
+	}
+}
+
+// Count returns the number of bits that are 1
+func (b BitString) Count() int {
+	count := 0
+	for _, c := range b.data {
+		count += bits.OnesCount8(c)
+	}
+	return count
+}
+
+// Bytes returns a copy of the bits, most significant bit first.
+// The bits after the end of the string in the last byte are 0.
+func (b BitString) Bytes() []byte {
+	return append([]byte(nil), b.data...)
+}
+
+// String returns the bits as 0s and 1s, like DuckDB
+func (b BitString) String() string {
+	var sb strings.Builder
+	sb.Grow(b.length)
+	for i := 0; i < b.length; i++ {
+		if b.Test(i) {
+			sb.WriteByte('1')
+		} else {
+			sb.WriteByte('0')
+		}
+	}
+	return sb.String()
+}
+
+// Value implements driver.Valuer.
+// The bits are passed in their text representation, which DuckDB casts to BIT.
+func (b BitString) Value() (driver.Value, error) {
+	return b.String(), nil
+}
+
+// Scan implements sql.Scanner
+func (b *BitString) Scan(src any) error {
+	if src == nil {
+		return errors.New("cannot scan NULL into BitString")
+	}
+
+	bs, err := toBitString(src)
+	if err != nil {
+		return err
+	}
+	*b = bs
+	return nil
+}
+
+// check panics if i is not the index of a bit
+func (b BitString) check(i int) {
+	if i < 0 || i >= b.length {
+		panic(fmt.Sprintf("bit index %d out of range for BitString of length %d", i, b.length))
+	}
+}
+
+// toBitString converts a BitString, or the text of one, to a BitString
+func toBitString(value any) (BitString, error) {
+	switch v := value.(type) {
+	case BitString:
+		return BitString{data: v.Bytes(), length: v.length}, nil
+	case *BitString:
+		return BitString{data: v.Bytes(), length: v.length}, nil
+	case string:
+		return ParseBitString(v)
+	case []byte:
+		return ParseBitString(string(v))
+	default:
+		return BitString{}, errors.Errorf("cannot convert %T to BIT", value)
+	}
+}
+
+// bitStringFromStorage decodes DuckDB's BIT storage.
+// The first byte holds the number of padding bits at the start of the second byte.
+func bitStringFromStorage(storage []byte) BitString {
+	if len(storage) < 2 {
+		return BitString{}
+	}
+	padding := int(storage[0])
+	b := NewBitString((len(storage)-1)*8 - padding)
+	for i := 0; i < b.length; i++ {
+		bit := padding + i
+		if storage[1+bit/8]&(0x80>>(bit%8)) != 0 {
+			b.data[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return b
+}
+
+// storage encodes the bits in DuckDB's BIT storage, the inverse of bitStringFromStorage.
+// Like DuckDB, the padding bits are 1.
+func (b BitString) storage() []byte {
+	padding := (8 - b.length%8) % 8
+	storage := make([]byte, 1+(padding+b.length+7)/8)
+	storage[0] = byte(padding)
+	for bit := 0; bit < padding; bit++ {
+		storage[1] |= 0x80 >> bit
+	}
+	for i := 0; i < b.length; i++ {
+		if b.Test(i) {
+			bit := padding + i
+			storage[1+bit/8] |= 0x80 >> (bit % 8)
+		}
+	}
+	return storage
+}
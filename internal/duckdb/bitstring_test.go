@@ -0,0 +1,94 @@
+package duckdb
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBitString(t *testing.T) {
+	b, err := ParseBitString("1011000001")
+	if err != nil {
+		t.Fatalf("ParseBitString failed: %v", err)
+	}
+	if b.Len() != 10 || b.Count() != 4 || !b.Test(0) || b.Test(1) || !b.Test(9) {
+		t.Errorf("ParseBitString() = %v, len %d, count %d", b, b.Len(), b.Count())
+	}
+
+	b.Set(1, true)
+	b.Set(0, false)
+	if got := b.String(); got != "0111000001" {
+		t.Errorf("String() = %v after Set, want 0111000001", got)
+	}
+	if got := b.Bytes(); !bytes.Equal(got, []byte{0b01110000, 0b01000000}) {
+		t.Errorf("Bytes() = %08b", got)
+	}
+
+	if _, err := ParseBitString("10a"); err == nil {
+		t.Errorf("ParseBitString(10a) succeeded, want error")
+	}
+	if got := BitStringFromBytes([]byte{0xf0}).String(); got != "11110000" {
+		t.Errorf("BitStringFromBytes() = %v, want 11110000", got)
+	}
+	if got := NewBitString(3).String(); got != "000" {
+		t.Errorf("NewBitString(3) = %v, want 000", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Test(10) did not panic")
+		}
+	}()
+	b.Test(10)
+}
+
+func TestBitStringScan(t *testing.T) {
+	original, _ := ParseBitString("101")
+
+	var b BitString
+	if err := b.Scan(original); err != nil || b.String() != "101" {
+		t.Errorf("Scan(BitString) = %v, %v", b, err)
+	}
+	// Scanned bit strings are copies
+	b.Set(0, false)
+	if !original.Test(0) {
+		t.Errorf("Scan(BitString) shares memory with the source")
+	}
+	if err := b.Scan([]byte("0011")); err != nil || b.String() != "0011" {
+		t.Errorf("Scan([]byte) = %v, %v", b, err)
+	}
+	if err := b.Scan(nil); err == nil {
+		t.Errorf("Scan(nil) succeeded, want error")
+	}
+	if value, err := original.Value(); err != nil || value != "101" {
+		t.Errorf("Value() = %v, %v, want 101", value, err)
+	}
+}
+
+func TestBitStringStorage(t *testing.T) {
+	// '10101' is stored with 3 padding bits, which DuckDB sets to 1
+	storage := []byte{3, 0b11110101}
+	b := bitStringFromStorage(storage)
+	if b.String() != "10101" {
+		t.Errorf("bitStringFromStorage() = %v, want 10101", b)
+	}
+	if got := b.storage(); !bytes.Equal(got, storage) {
+		t.Errorf("storage() = %08b, want %08b", got, storage)
+	}
+
+	for _, s := range []string{"1", "00000000", "110000000011", "0101010101010101010"} {
+		b, _ := ParseBitString(s)
+		if got := bitStringFromStorage(b.storage()).String(); got != s {
+			t.Errorf("round trip of %s = %s", s, got)
+		}
+	}
+
+	// A duckdb_string_t of 2 inlined bytes: 1 padding bit, then the bits
+	v := testVector(DuckDBTypeBit, make([]uint64, 2), nil)
+	copy((*[16]byte)(v.data)[:], []byte{2, 0, 0, 0, 1, 0b10101011})
+	if got, ok := v.Value(0).(BitString); !ok || got.String() != "0101011" {
+		t.Errorf("Value(0) = %v, want 0101011", v.Value(0))
+	}
+	if got := v.text(0); got != "0101011" {
+		t.Errorf("text(0) = %v, want 0101011", got)
+	}
+}
@@ -0,0 +1,26 @@
+package duckdb
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// toBlob converts a Go value to the bytes of a BLOB.
+// Readers are read to the end.
+func toBlob(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	case io.Reader:
+		b, err := io.ReadAll(v)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read BLOB")
+		}
+		return b, nil
+	default:
+		return nil, errors.Errorf("cannot convert %T to BLOB", value)
+	}
+}
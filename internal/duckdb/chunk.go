@@ -0,0 +1,58 @@
+package duckdb
+
+import (
+	"unsafe"
+)
+
+// DataChunk wraps a duckdb_data_chunk fetched from a result
+type DataChunk struct {
+	db     *DB
+	handle DuckDBDataChunk
+}
+
+// FetchChunk fetches the next data chunk of the result.
+// It returns a nil chunk once the result is exhausted.
+// For streaming results this is what drives the query execution.
+func (r *Result) FetchChunk() (*DataChunk, error) {
+	handle := r.Db.FetchChunk(&r.Raw)
+	if handle == nil {
+		// A streaming result reports execution errors while fetching
+		if r.Db.ResultError != nil && r.Db.ResultError(&r.Raw) != nil {
+			return nil, r.Db.resultError(&r.Raw, r.sql)
+		}
+		return nil, nil
+	}
+
+	return &DataChunk{
+		db:     r.Db,
+		handle: handle,
+	}, nil
+}
+
+// Size returns the number of rows in the chunk
+func (c *DataChunk) Size() int {
+	return int(c.db.DataChunkGetSize(c.handle))
+}
+
+// Vector returns the vector of the given column
+func (c *DataChunk) Vector(column int) DuckDBVector {
+	return c.db.DataChunkGetVector(c.handle, int64(column))
+}
+
+// Close destroys the chunk and frees associated resources
+func (c *DataChunk) Close() {
+	if c.handle == nil {
+		return
+	}
+	c.db.DestroyDataChunk(&c.handle)
+	c.handle = nil
+}
+
+// takeString copies a string allocated by DuckDB and frees the original
+func (db *DB) takeString(c *byte) string {
+	s := GoString(c)
+	if c != nil && db.Free != nil {
+		db.Free(unsafe.Pointer(c))
+	}
+	return s
+}
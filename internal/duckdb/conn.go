@@ -2,12 +2,77 @@ package duckdb
 
 import (
 	"fmt"
+	"time"
 )
 
 // DuckDBConnection represents a connection to a DuckDB database
 type Connection struct {
 	handle DuckDBConnection
 	db     *DB
+	// location overrides the session TimeZone for TIMESTAMP WITH TIME ZONE results
+	location *time.Location
+	// timeZone caches the session TimeZone setting until a SET statement may change it
+	timeZone *time.Location
+}
+
+// Location returns the time zone that TIMESTAMP WITH TIME ZONE values are returned in:
+// the location set with SetLocation, or else the session's TimeZone setting as of the
+// last streaming query.
+func (c *Connection) Location() *time.Location {
+	if c.location != nil {
+		return c.location
+	}
+	if c.timeZone != nil {
+		return c.timeZone
+	}
+	return time.UTC
+}
+
+// SetLocation sets the time zone that TIMESTAMP WITH TIME ZONE values are returned in.
+// A nil location selects the session's TimeZone setting.
+func (c *Connection) SetLocation(loc *time.Location) {
+	c.location = loc
+}
+
+// refreshTimeZone reads the session's TimeZone setting unless it is overridden or
+// known to be unchanged. It runs before a streaming query is executed, because
+// running a query while a streaming result is open would close that result.
+func (c *Connection) refreshTimeZone() {
+	if c.location != nil || c.timeZone != nil {
+		return
+	}
+
+	loc, err := c.TimeZone()
+	if err != nil {
+		// DuckDB builds without time zone support use UTC
+		loc = time.UTC
+	}
+	c.timeZone = loc
+}
+
+// executed forgets the cached TimeZone setting after a SET statement, which may have changed it
+func (c *Connection) executed(result *Result) {
+	if c.db.ResultStatementType != nil && c.db.ResultStatementType(&result.Raw) == DuckDBStatementTypeSet {
+		c.timeZone = nil
+	}
+}
+
+// TimeZone returns the location of the session's TimeZone setting
+func (c *Connection) TimeZone() (*time.Location, error) {
+	result, err := c.Query("SELECT current_setting('TimeZone')")
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	if result.RowCount() == 0 {
+		return nil, fmt.Errorf("TimeZone setting is not available")
+	}
+	name, ok := result.ValueString(0, 0)
+	if !ok {
+		return nil, fmt.Errorf("TimeZone setting is not available")
+	}
+	return time.LoadLocation(name)
 }
 
 // Query executes a SQL query and returns the result
@@ -18,14 +83,75 @@ func (c *Connection) Query(sql string) (*Result, error) {
 
 	state := c.db.Query(c.handle, cQuery, &rawResult)
 	if state != DuckDBSuccess {
-		return nil, fmt.Errorf("Query failed: %s", sql)
+		// The result carries the error and must be destroyed even on failure
+		err := c.db.resultError(&rawResult, sql)
+		c.db.DestroyResult(&rawResult)
+		return nil, err
 	}
 
 	internalResult := newResult(c.db, rawResult)
+	internalResult.sql = sql
+	c.executed(internalResult)
 
 	return internalResult, nil
 }
 
+// QueryStreaming executes a SQL query and returns a streaming result.
+// Rows of a streaming result are produced while its chunks are fetched, and running
+// another query on the connection invalidates the result, so callers fetch the chunks
+// they still need before that.
+// If the query contains several statements, all but the last one are
+// executed first and the result of the last one is returned.
+func (c *Connection) QueryStreaming(sql string) (*Result, error) {
+	var extracted DuckDBExtractedStatements
+	cQuery := ToCString(sql)
+	defer FreeCString(cQuery)
+
+	count := c.db.ExtractStatements(c.handle, cQuery, &extracted)
+	defer c.db.DestroyExtracted(&extracted)
+	if count == 0 {
+		return nil, newError(DuckDBErrorInvalid, GoString(c.db.ExtractStatementsError(extracted)), sql)
+	}
+
+	for i := int64(0); i < count; i++ {
+		var handle DuckDBPreparedStatement
+		state := c.db.PrepareExtractedStatement(c.handle, extracted, i, &handle)
+		if state != DuckDBSuccess {
+			errMsg := GoString(c.db.PrepareError(handle))
+			c.db.DestroyPrepared(&handle)
+			return nil, newError(DuckDBErrorInvalid, errMsg, sql)
+		}
+
+		stmt := &PreparedStatement{
+			handle:    handle,
+			conn:      c,
+			query:     sql,
+			numParams: int32(c.db.NumParams(handle)),
+		}
+
+		if i < count-1 {
+			result, err := stmt.Execute()
+			_ = stmt.Close()
+			if err != nil {
+				return nil, err
+			}
+			result.Close()
+			continue
+		}
+
+		result, err := stmt.ExecuteStreaming()
+		if err != nil {
+			_ = stmt.Close()
+			return nil, err
+		}
+		// The statement must outlive the rows streamed from it
+		result.stmt = stmt
+		return result, nil
+	}
+
+	return nil, newError(DuckDBErrorInvalid, "no statements to execute", sql)
+}
+
 // Execute runs a SQL statement that doesn't return a result
 func (c *Connection) Execute(sql string) error {
 	result, err := c.Query(sql)
@@ -57,9 +183,11 @@ func (c *Connection) Prepare(query string) (*PreparedStatement, error) {
 			if c.db.DestroyPrepared != nil {
 				c.db.DestroyPrepared(&stmt)
 			}
-			return nil, fmt.Errorf("failed to prepare statement: %s", errMsg)
+			// The C API does not report the error type of a failed prepare,
+			// so it is derived from the message
+			return nil, newError(DuckDBErrorInvalid, errMsg, query)
 		}
-		return nil, fmt.Errorf("failed to prepare statement")
+		return nil, newError(DuckDBErrorInvalid, "failed to prepare statement", query)
 	}
 
 	// Get the number of parameters
@@ -72,10 +200,20 @@ func (c *Connection) Prepare(query string) (*PreparedStatement, error) {
 	return &PreparedStatement{
 		handle:    stmt,
 		conn:      c,
+		query:     query,
 		numParams: numParams,
 	}, nil
 }
 
+// Interrupt interrupts the query running on the connection.
+// It is safe to call from another goroutine; the interrupted query fails
+// and the connection remains usable for later queries.
+func (c *Connection) Interrupt() {
+	if c.db.Interrupt != nil {
+		c.db.Interrupt(c.handle)
+	}
+}
+
 // Close closes the connection
 func (c *Connection) Close() {
 	c.db.Disconnect(&c.handle)
@@ -2,6 +2,7 @@ package duckdb
 
 import (
 	"testing"
+	"time"
 )
 
 func TestConnectionQuery(t *testing.T) {
@@ -35,6 +36,44 @@ func TestConnectionQuery(t *testing.T) {
 	}
 }
 
+func TestConnectionQueryError(t *testing.T) {
+	conn := testConnection()
+
+	errMsg := ToCString("Parser Error: syntax error at or near \"INVALID\"")
+	conn.db.Query = func(_ DuckDBConnection, _ *byte, _ *DuckDBResultRaw) DuckDBState {
+		return DuckDBError
+	}
+	conn.db.ResultError = func(*DuckDBResultRaw) *byte {
+		return errMsg
+	}
+	conn.db.ResultErrorType = func(*DuckDBResultRaw) DuckDBErrorType {
+		return DuckDBErrorParser
+	}
+
+	var destroyed bool
+	conn.db.DestroyResult = func(*DuckDBResultRaw) {
+		destroyed = true
+	}
+
+	_, err := conn.Query("INVALID SQL")
+	duckErr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Expected *Error, got %T", err)
+	}
+	if duckErr.Type != DuckDBErrorParser {
+		t.Errorf("Expected parser error, got %v", duckErr.Type)
+	}
+	if duckErr.Message != GoString(errMsg) {
+		t.Errorf("Expected DuckDB message, got %q", duckErr.Message)
+	}
+	if duckErr.SQL != "INVALID SQL" {
+		t.Errorf("Expected SQL 'INVALID SQL', got %q", duckErr.SQL)
+	}
+	if !destroyed {
+		t.Errorf("Expected failed result to be destroyed")
+	}
+}
+
 func TestConnectionExecute(t *testing.T) {
 	// Create a test connection
 	conn := testConnection()
@@ -103,3 +142,51 @@ func TestConnectionPrepare(t *testing.T) {
 		t.Errorf("Expected nil statement for failed prepare")
 	}
 }
+
+func TestConnectionTimeZoneRefresh(t *testing.T) {
+	conn := testConnection()
+
+	var queries int
+	conn.db.Query = func(_ DuckDBConnection, _ *byte, _ *DuckDBResultRaw) DuckDBState {
+		// Without time zone support the setting cannot be read
+		queries++
+		return DuckDBError
+	}
+	conn.db.ResultError = func(*DuckDBResultRaw) *byte { return nil }
+	conn.db.ResultErrorType = func(*DuckDBResultRaw) DuckDBErrorType { return DuckDBErrorInvalid }
+	conn.db.DestroyResult = func(*DuckDBResultRaw) {}
+
+	statementType := DuckDBStatementTypeSelect
+	conn.db.ResultStatementType = func(*DuckDBResultRaw) DuckDBStatementType { return statementType }
+
+	conn.refreshTimeZone()
+	conn.refreshTimeZone()
+	if queries != 1 {
+		t.Errorf("Expected the TimeZone setting to be read once, got %d queries", queries)
+	}
+	if conn.Location() != time.UTC {
+		t.Errorf("Expected UTC without time zone support, got %v", conn.Location())
+	}
+
+	// Only SET statements may change the setting
+	conn.executed(&Result{})
+	conn.refreshTimeZone()
+	if queries != 1 {
+		t.Errorf("Expected the TimeZone setting to be cached after SELECT, got %d queries", queries)
+	}
+	statementType = DuckDBStatementTypeSet
+	conn.executed(&Result{})
+	conn.refreshTimeZone()
+	if queries != 2 {
+		t.Errorf("Expected the TimeZone setting to be read after SET, got %d queries", queries)
+	}
+
+	// A configured location is used without reading the setting
+	berlin := time.FixedZone("CEST", 2*3600)
+	conn.SetLocation(berlin)
+	conn.executed(&Result{})
+	conn.refreshTimeZone()
+	if queries != 2 || conn.Location() != berlin {
+		t.Errorf("Expected the configured location without queries, got %v after %d queries", conn.Location(), queries)
+	}
+}
@@ -2,6 +2,7 @@ package duckdb
 
 import (
 	"fmt"
+	"sort"
 	"unsafe"
 
 	"github.com/ebitengine/purego"
@@ -21,6 +22,7 @@ type DB struct {
 	Connect           func(DuckDBDatabase, *DuckDBConnection) DuckDBState
 	Close             func(*DuckDBDatabase)
 	Disconnect        func(*DuckDBConnection)
+	Interrupt         func(DuckDBConnection)
 	LibraryVersion    func() *byte
 	Query             func(DuckDBConnection, *byte, *DuckDBResultRaw) DuckDBState
 	ColumnName        func(*DuckDBResultRaw, int64) *byte
@@ -53,17 +55,97 @@ type DB struct {
 	DestroyResult        func(*DuckDBResultRaw)
 
 	// Prepared statement functions
-	Prepare         func(DuckDBConnection, *byte, *DuckDBPreparedStatement) DuckDBState
-	DestroyPrepared func(*DuckDBPreparedStatement)
-	ExecutePrepared func(DuckDBPreparedStatement, *DuckDBResultRaw) DuckDBState
-	NumParams       func(DuckDBPreparedStatement) int64
-	PrepareError    func(DuckDBPreparedStatement) *byte
+	Prepare                  func(DuckDBConnection, *byte, *DuckDBPreparedStatement) DuckDBState
+	DestroyPrepared          func(*DuckDBPreparedStatement)
+	ExecutePrepared          func(DuckDBPreparedStatement, *DuckDBResultRaw) DuckDBState
+	ExecutePreparedStreaming func(DuckDBPreparedStatement, *DuckDBResultRaw) DuckDBState
+	NumParams                func(DuckDBPreparedStatement) int64
+	PrepareError             func(DuckDBPreparedStatement) *byte
 	// Additional prepared statement functions
-	ParameterName    func(DuckDBPreparedStatement, int64) *byte
-	ParamType        func(DuckDBPreparedStatement, int64) DuckDBType
-	ParamLogicalType func(DuckDBPreparedStatement, int64) DuckDBLogicalType
-	ClearBindings    func(DuckDBPreparedStatement) DuckDBState
-	StatementType    func(DuckDBPreparedStatement) int32
+	ParameterName      func(DuckDBPreparedStatement, int64) *byte
+	BindParameterIndex func(DuckDBPreparedStatement, *uint64, *byte) DuckDBState
+	ParamType          func(DuckDBPreparedStatement, int64) DuckDBType
+	ParamLogicalType   func(DuckDBPreparedStatement, int64) DuckDBLogicalType
+	ClearBindings      func(DuckDBPreparedStatement) DuckDBState
+	StatementType      func(DuckDBPreparedStatement) int32
+
+	// Extracted statement functions
+	ExtractStatements         func(DuckDBConnection, *byte, *DuckDBExtractedStatements) int64
+	PrepareExtractedStatement func(DuckDBConnection, DuckDBExtractedStatements, int64, *DuckDBPreparedStatement) DuckDBState
+	ExtractStatementsError    func(DuckDBExtractedStatements) *byte
+	DestroyExtracted          func(*DuckDBExtractedStatements)
+
+	// Appender functions
+	AppenderCreate      func(DuckDBConnection, *byte, *byte, *DuckDBAppender) DuckDBState
+	AppenderColumnCount func(DuckDBAppender) int64
+	AppenderColumnType  func(DuckDBAppender, int64) DuckDBLogicalType
+	AppenderError       func(DuckDBAppender) *byte
+	AppenderFlush       func(DuckDBAppender) DuckDBState
+	AppenderClose       func(DuckDBAppender) DuckDBState
+	AppenderDestroy     func(*DuckDBAppender) DuckDBState
+	AppenderEndRow      func(DuckDBAppender) DuckDBState
+	AppendNull          func(DuckDBAppender) DuckDBState
+	AppendBool          func(DuckDBAppender, bool) DuckDBState
+	AppendInt8          func(DuckDBAppender, int8) DuckDBState
+	AppendInt16         func(DuckDBAppender, int16) DuckDBState
+	AppendInt32         func(DuckDBAppender, int32) DuckDBState
+	AppendInt64         func(DuckDBAppender, int64) DuckDBState
+	AppendUint8         func(DuckDBAppender, uint8) DuckDBState
+	AppendUint16        func(DuckDBAppender, uint16) DuckDBState
+	AppendUint32        func(DuckDBAppender, uint32) DuckDBState
+	AppendUint64        func(DuckDBAppender, uint64) DuckDBState
+	AppendFloat         func(DuckDBAppender, float32) DuckDBState
+	AppendDouble        func(DuckDBAppender, float64) DuckDBState
+	AppendDate          func(DuckDBAppender, int32) DuckDBState
+	AppendTime          func(DuckDBAppender, int64) DuckDBState
+	AppendTimestamp     func(DuckDBAppender, int64) DuckDBState
+	AppendVarcharLength func(DuckDBAppender, *byte, int64) DuckDBState
+	AppendBlob          func(DuckDBAppender, unsafe.Pointer, int64) DuckDBState
+
+	// Scalar function functions
+	CreateScalarFunction             func() DuckDBScalarFunction
+	DestroyScalarFunction            func(*DuckDBScalarFunction)
+	ScalarFunctionSetName            func(DuckDBScalarFunction, *byte)
+	ScalarFunctionAddParameter       func(DuckDBScalarFunction, DuckDBLogicalType)
+	ScalarFunctionSetVarargs         func(DuckDBScalarFunction, DuckDBLogicalType)
+	ScalarFunctionSetReturnType      func(DuckDBScalarFunction, DuckDBLogicalType)
+	ScalarFunctionSetVolatile        func(DuckDBScalarFunction)
+	ScalarFunctionSetSpecialHandling func(DuckDBScalarFunction)
+	ScalarFunctionSetExtraInfo       func(DuckDBScalarFunction, uintptr, uintptr)
+	ScalarFunctionSetFunction        func(DuckDBScalarFunction, uintptr)
+	RegisterScalarFunction           func(DuckDBConnection, DuckDBScalarFunction) DuckDBState
+	ScalarFunctionGetExtraInfo       func(DuckDBFunctionInfo) uintptr
+	ScalarFunctionSetError           func(DuckDBFunctionInfo, *byte)
+
+	// Table function functions
+	CreateTableFunction                     func() DuckDBTableFunction
+	DestroyTableFunction                    func(*DuckDBTableFunction)
+	TableFunctionSetName                    func(DuckDBTableFunction, *byte)
+	TableFunctionAddParameter               func(DuckDBTableFunction, DuckDBLogicalType)
+	TableFunctionAddNamedParameter          func(DuckDBTableFunction, *byte, DuckDBLogicalType)
+	TableFunctionSetExtraInfo               func(DuckDBTableFunction, uintptr, uintptr)
+	TableFunctionSetBind                    func(DuckDBTableFunction, uintptr)
+	TableFunctionSetInit                    func(DuckDBTableFunction, uintptr)
+	TableFunctionSetFunction                func(DuckDBTableFunction, uintptr)
+	TableFunctionSupportsProjectionPushdown func(DuckDBTableFunction, bool)
+	RegisterTableFunction                   func(DuckDBConnection, DuckDBTableFunction) DuckDBState
+	BindGetExtraInfo                        func(DuckDBBindInfo) uintptr
+	BindAddResultColumn                     func(DuckDBBindInfo, *byte, DuckDBLogicalType)
+	BindGetParameterCount                   func(DuckDBBindInfo) int64
+	BindGetParameter                        func(DuckDBBindInfo, int64) DuckDBValue
+	BindGetNamedParameter                   func(DuckDBBindInfo, *byte) DuckDBValue
+	BindSetBindData                         func(DuckDBBindInfo, uintptr, uintptr)
+	BindSetCardinality                      func(DuckDBBindInfo, int64, bool)
+	BindSetError                            func(DuckDBBindInfo, *byte)
+	InitGetBindData                         func(DuckDBInitInfo) uintptr
+	InitSetInitData                         func(DuckDBInitInfo, uintptr, uintptr)
+	InitGetColumnCount                      func(DuckDBInitInfo) int64
+	InitGetColumnIndex                      func(DuckDBInitInfo, int64) uint64
+	InitSetError                            func(DuckDBInitInfo, *byte)
+	FunctionGetBindData                     func(DuckDBFunctionInfo) uintptr
+	FunctionGetInitData                     func(DuckDBFunctionInfo) uintptr
+	FunctionSetError                        func(DuckDBFunctionInfo, *byte)
+	VectorSize                              func() int64
 
 	// Parameter binding functions
 	BindNull      func(DuckDBPreparedStatement, int32) DuckDBState
@@ -83,23 +165,51 @@ type DB struct {
 	BindDate      func(DuckDBPreparedStatement, int32, int32) DuckDBState
 	BindTime      func(DuckDBPreparedStatement, int32, int64) DuckDBState
 	BindTimestamp func(DuckDBPreparedStatement, int32, int64) DuckDBState
-	// BindInterval is not supported due to purego limitations
+	BindDecimal   func(DuckDBPreparedStatement, int32, *decimal) DuckDBState
+	BindInterval  func(DuckDBPreparedStatement, int32, *Interval) DuckDBState
+	BindHugeint   func(DuckDBPreparedStatement, int32, *hugeint) DuckDBState
+	BindUHugeint  func(DuckDBPreparedStatement, int32, *hugeint) DuckDBState
+	BindValue     func(DuckDBPreparedStatement, int32, DuckDBValue) DuckDBState
 
 	// Error handling
-	ResultError func(*DuckDBResultRaw) *byte
+	ResultError     func(*DuckDBResultRaw) *byte
+	ResultErrorType func(*DuckDBResultRaw) DuckDBErrorType
 
 	// Value interface functions
 	DestroyValue    func(*DuckDBValue)
 	CreateVarchar   func(string) DuckDBValue
 	CreateInt32     func(int32) DuckDBValue
 	CreateInt64     func(int64) DuckDBValue
+	CreateUint64    func(uint64) DuckDBValue
 	CreateDouble    func(float64) DuckDBValue
 	CreateBool      func(bool) DuckDBValue
+	CreateTimestamp func(int64) DuckDBValue
 	CreateListValue func(DuckDBLogicalType, *DuckDBValue, int64) DuckDBValue
 	GetListSize     func(DuckDBValue) int64
 	GetListChild    func(DuckDBValue, int64) DuckDBValue
 	IsNullValue     func(DuckDBValue) bool
 	CreateNullValue func() DuckDBValue
+	GetValueType    func(DuckDBValue) DuckDBLogicalType
+	GetBool         func(DuckDBValue) bool
+	GetInt8         func(DuckDBValue) int8
+	GetInt16        func(DuckDBValue) int16
+	GetInt32        func(DuckDBValue) int32
+	GetInt64        func(DuckDBValue) int64
+	GetUint8        func(DuckDBValue) uint8
+	GetUint16       func(DuckDBValue) uint16
+	GetUint32       func(DuckDBValue) uint32
+	GetUint64       func(DuckDBValue) uint64
+	GetFloat        func(DuckDBValue) float32
+	GetDouble       func(DuckDBValue) float64
+	GetDate         func(DuckDBValue) int32
+	GetTime         func(DuckDBValue) int64
+	GetTimestamp    func(DuckDBValue) int64
+	GetVarchar      func(DuckDBValue) *byte
+
+	// CreateStructValue and CreateMapValue build nested parameter values.
+	// CreateMapValue is nil if the library does not have it.
+	CreateStructValue func(DuckDBLogicalType, *DuckDBValue) DuckDBValue
+	CreateMapValue    func(DuckDBLogicalType, *DuckDBValue, *DuckDBValue, int64) DuckDBValue
 
 	// Data Chunk interface functions
 	FetchChunk              func(*DuckDBResultRaw) DuckDBDataChunk
@@ -107,6 +217,7 @@ type DB struct {
 	ResultGetChunk          func(*DuckDBResultRaw, int64) DuckDBDataChunk
 	ResultChunkCount        func(*DuckDBResultRaw) int64
 	ResultIsStreaming       func(*DuckDBResultRaw) bool
+	ResultStatementType     func(*DuckDBResultRaw) DuckDBStatementType
 	CreateDataChunk         func(*DuckDBLogicalType, int64) DuckDBDataChunk
 	DestroyDataChunk        func(*DuckDBDataChunk)
 	DataChunkReset          func(DuckDBDataChunk)
@@ -133,15 +244,36 @@ type DB struct {
 	CreateLogicalType   func(DuckDBType) DuckDBLogicalType
 	LogicalTypeGetAlias func(DuckDBLogicalType) *byte
 	CreateListType      func(DuckDBLogicalType) DuckDBLogicalType
+	CreateStructType    func(*DuckDBLogicalType, **byte, int64) DuckDBLogicalType
+	CreateMapType       func(DuckDBLogicalType, DuckDBLogicalType) DuckDBLogicalType
 	ListTypeChildType   func(DuckDBLogicalType) DuckDBLogicalType
 	GetTypeID           func(DuckDBLogicalType) DuckDBType
 	DecimalWidth        func(DuckDBLogicalType) uint8
 	DecimalScale        func(DuckDBLogicalType) uint8
 	DestroyLogicalType  func(*DuckDBLogicalType)
+	// Nested and enum logical types
+	EnumInternalType     func(DuckDBLogicalType) DuckDBType
+	EnumDictionarySize   func(DuckDBLogicalType) uint32
+	EnumDictionaryValue  func(DuckDBLogicalType, int64) *byte
+	StructTypeChildCount func(DuckDBLogicalType) int64
+	StructTypeChildName  func(DuckDBLogicalType, int64) *byte
+	StructTypeChildType  func(DuckDBLogicalType, int64) DuckDBLogicalType
+	MapTypeKeyType       func(DuckDBLogicalType) DuckDBLogicalType
+	MapTypeValueType     func(DuckDBLogicalType) DuckDBLogicalType
+	ArrayTypeArraySize   func(DuckDBLogicalType) int64
+	ArrayTypeChildType   func(DuckDBLogicalType) DuckDBLogicalType
+	UnionTypeMemberCount func(DuckDBLogicalType) int64
+	UnionTypeMemberName  func(DuckDBLogicalType, int64) *byte
+	UnionTypeMemberType  func(DuckDBLogicalType, int64) DuckDBLogicalType
+
+	// Memory management
+	Free func(unsafe.Pointer)
 }
 
-// NewDB creates a new internal database instance
-func NewDB(path string) (*DB, error) {
+// NewDB creates a new internal database instance.
+// options are DuckDB configuration options, like "threads" or "access_mode",
+// that are applied when the database is opened.
+func NewDB(path string, options map[string]string) (*DB, error) {
 	db := &DB{}
 
 	// Load DuckDB library
@@ -152,11 +284,10 @@ func NewDB(path string) (*DB, error) {
 	db.Lib = lib
 
 	// Register DuckDB functions
-	var open func(path string, out *DuckDBDatabase) DuckDBState
-	purego.RegisterLibFunc(&open, lib, "duckdb_open")
 	purego.RegisterLibFunc(&db.Connect, lib, "duckdb_connect")
 	purego.RegisterLibFunc(&db.Close, lib, "duckdb_close")
 	purego.RegisterLibFunc(&db.Disconnect, lib, "duckdb_disconnect")
+	purego.RegisterLibFunc(&db.Interrupt, lib, "duckdb_interrupt")
 	purego.RegisterLibFunc(&db.LibraryVersion, lib, "duckdb_library_version")
 	purego.RegisterLibFunc(&db.Query, lib, "duckdb_query")
 	purego.RegisterLibFunc(&db.ColumnName, lib, "duckdb_column_name")
@@ -195,14 +326,94 @@ func NewDB(path string) (*DB, error) {
 	purego.RegisterLibFunc(&db.Prepare, lib, "duckdb_prepare")
 	purego.RegisterLibFunc(&db.DestroyPrepared, lib, "duckdb_destroy_prepare")
 	purego.RegisterLibFunc(&db.ExecutePrepared, lib, "duckdb_execute_prepared")
+	purego.RegisterLibFunc(&db.ExecutePreparedStreaming, lib, "duckdb_execute_prepared_streaming")
 	purego.RegisterLibFunc(&db.NumParams, lib, "duckdb_nparams")
 	purego.RegisterLibFunc(&db.PrepareError, lib, "duckdb_prepare_error")
 	purego.RegisterLibFunc(&db.ParameterName, lib, "duckdb_parameter_name")
+	purego.RegisterLibFunc(&db.BindParameterIndex, lib, "duckdb_bind_parameter_index")
 	purego.RegisterLibFunc(&db.ParamType, lib, "duckdb_param_type")
 	purego.RegisterLibFunc(&db.ParamLogicalType, lib, "duckdb_param_logical_type")
 	purego.RegisterLibFunc(&db.ClearBindings, lib, "duckdb_clear_bindings")
 	purego.RegisterLibFunc(&db.StatementType, lib, "duckdb_prepared_statement_type")
 
+	// Register extracted statement functions
+	purego.RegisterLibFunc(&db.ExtractStatements, lib, "duckdb_extract_statements")
+	purego.RegisterLibFunc(&db.PrepareExtractedStatement, lib, "duckdb_prepare_extracted_statement")
+	purego.RegisterLibFunc(&db.ExtractStatementsError, lib, "duckdb_extract_statements_error")
+	purego.RegisterLibFunc(&db.DestroyExtracted, lib, "duckdb_destroy_extracted")
+
+	// Register appender functions
+	purego.RegisterLibFunc(&db.AppenderCreate, lib, "duckdb_appender_create")
+	purego.RegisterLibFunc(&db.AppenderColumnCount, lib, "duckdb_appender_column_count")
+	purego.RegisterLibFunc(&db.AppenderColumnType, lib, "duckdb_appender_column_type")
+	purego.RegisterLibFunc(&db.AppenderError, lib, "duckdb_appender_error")
+	purego.RegisterLibFunc(&db.AppenderFlush, lib, "duckdb_appender_flush")
+	purego.RegisterLibFunc(&db.AppenderClose, lib, "duckdb_appender_close")
+	purego.RegisterLibFunc(&db.AppenderDestroy, lib, "duckdb_appender_destroy")
+	purego.RegisterLibFunc(&db.AppenderEndRow, lib, "duckdb_appender_end_row")
+	purego.RegisterLibFunc(&db.AppendNull, lib, "duckdb_append_null")
+	purego.RegisterLibFunc(&db.AppendBool, lib, "duckdb_append_bool")
+	purego.RegisterLibFunc(&db.AppendInt8, lib, "duckdb_append_int8")
+	purego.RegisterLibFunc(&db.AppendInt16, lib, "duckdb_append_int16")
+	purego.RegisterLibFunc(&db.AppendInt32, lib, "duckdb_append_int32")
+	purego.RegisterLibFunc(&db.AppendInt64, lib, "duckdb_append_int64")
+	purego.RegisterLibFunc(&db.AppendUint8, lib, "duckdb_append_uint8")
+	purego.RegisterLibFunc(&db.AppendUint16, lib, "duckdb_append_uint16")
+	purego.RegisterLibFunc(&db.AppendUint32, lib, "duckdb_append_uint32")
+	purego.RegisterLibFunc(&db.AppendUint64, lib, "duckdb_append_uint64")
+	purego.RegisterLibFunc(&db.AppendFloat, lib, "duckdb_append_float")
+	purego.RegisterLibFunc(&db.AppendDouble, lib, "duckdb_append_double")
+	purego.RegisterLibFunc(&db.AppendDate, lib, "duckdb_append_date")
+	purego.RegisterLibFunc(&db.AppendTime, lib, "duckdb_append_time")
+	purego.RegisterLibFunc(&db.AppendTimestamp, lib, "duckdb_append_timestamp")
+	purego.RegisterLibFunc(&db.AppendVarcharLength, lib, "duckdb_append_varchar_length")
+	purego.RegisterLibFunc(&db.AppendBlob, lib, "duckdb_append_blob")
+
+	// Register scalar function functions
+	purego.RegisterLibFunc(&db.CreateScalarFunction, lib, "duckdb_create_scalar_function")
+	purego.RegisterLibFunc(&db.DestroyScalarFunction, lib, "duckdb_destroy_scalar_function")
+	purego.RegisterLibFunc(&db.ScalarFunctionSetName, lib, "duckdb_scalar_function_set_name")
+	purego.RegisterLibFunc(&db.ScalarFunctionAddParameter, lib, "duckdb_scalar_function_add_parameter")
+	purego.RegisterLibFunc(&db.ScalarFunctionSetVarargs, lib, "duckdb_scalar_function_set_varargs")
+	purego.RegisterLibFunc(&db.ScalarFunctionSetReturnType, lib, "duckdb_scalar_function_set_return_type")
+	purego.RegisterLibFunc(&db.ScalarFunctionSetVolatile, lib, "duckdb_scalar_function_set_volatile")
+	purego.RegisterLibFunc(&db.ScalarFunctionSetSpecialHandling, lib, "duckdb_scalar_function_set_special_handling")
+	purego.RegisterLibFunc(&db.ScalarFunctionSetExtraInfo, lib, "duckdb_scalar_function_set_extra_info")
+	purego.RegisterLibFunc(&db.ScalarFunctionSetFunction, lib, "duckdb_scalar_function_set_function")
+	purego.RegisterLibFunc(&db.RegisterScalarFunction, lib, "duckdb_register_scalar_function")
+	purego.RegisterLibFunc(&db.ScalarFunctionGetExtraInfo, lib, "duckdb_scalar_function_get_extra_info")
+	purego.RegisterLibFunc(&db.ScalarFunctionSetError, lib, "duckdb_scalar_function_set_error")
+
+	// Register table function functions
+	purego.RegisterLibFunc(&db.CreateTableFunction, lib, "duckdb_create_table_function")
+	purego.RegisterLibFunc(&db.DestroyTableFunction, lib, "duckdb_destroy_table_function")
+	purego.RegisterLibFunc(&db.TableFunctionSetName, lib, "duckdb_table_function_set_name")
+	purego.RegisterLibFunc(&db.TableFunctionAddParameter, lib, "duckdb_table_function_add_parameter")
+	purego.RegisterLibFunc(&db.TableFunctionAddNamedParameter, lib, "duckdb_table_function_add_named_parameter")
+	purego.RegisterLibFunc(&db.TableFunctionSetExtraInfo, lib, "duckdb_table_function_set_extra_info")
+	purego.RegisterLibFunc(&db.TableFunctionSetBind, lib, "duckdb_table_function_set_bind")
+	purego.RegisterLibFunc(&db.TableFunctionSetInit, lib, "duckdb_table_function_set_init")
+	purego.RegisterLibFunc(&db.TableFunctionSetFunction, lib, "duckdb_table_function_set_function")
+	purego.RegisterLibFunc(&db.TableFunctionSupportsProjectionPushdown, lib, "duckdb_table_function_supports_projection_pushdown")
+	purego.RegisterLibFunc(&db.RegisterTableFunction, lib, "duckdb_register_table_function")
+	purego.RegisterLibFunc(&db.BindGetExtraInfo, lib, "duckdb_bind_get_extra_info")
+	purego.RegisterLibFunc(&db.BindAddResultColumn, lib, "duckdb_bind_add_result_column")
+	purego.RegisterLibFunc(&db.BindGetParameterCount, lib, "duckdb_bind_get_parameter_count")
+	purego.RegisterLibFunc(&db.BindGetParameter, lib, "duckdb_bind_get_parameter")
+	purego.RegisterLibFunc(&db.BindGetNamedParameter, lib, "duckdb_bind_get_named_parameter")
+	purego.RegisterLibFunc(&db.BindSetBindData, lib, "duckdb_bind_set_bind_data")
+	purego.RegisterLibFunc(&db.BindSetCardinality, lib, "duckdb_bind_set_cardinality")
+	purego.RegisterLibFunc(&db.BindSetError, lib, "duckdb_bind_set_error")
+	purego.RegisterLibFunc(&db.InitGetBindData, lib, "duckdb_init_get_bind_data")
+	purego.RegisterLibFunc(&db.InitSetInitData, lib, "duckdb_init_set_init_data")
+	purego.RegisterLibFunc(&db.InitGetColumnCount, lib, "duckdb_init_get_column_count")
+	purego.RegisterLibFunc(&db.InitGetColumnIndex, lib, "duckdb_init_get_column_index")
+	purego.RegisterLibFunc(&db.InitSetError, lib, "duckdb_init_set_error")
+	purego.RegisterLibFunc(&db.FunctionGetBindData, lib, "duckdb_function_get_bind_data")
+	purego.RegisterLibFunc(&db.FunctionGetInitData, lib, "duckdb_function_get_init_data")
+	purego.RegisterLibFunc(&db.FunctionSetError, lib, "duckdb_function_set_error")
+	purego.RegisterLibFunc(&db.VectorSize, lib, "duckdb_vector_size")
+
 	// Register parameter binding functions
 	purego.RegisterLibFunc(&db.BindNull, lib, "duckdb_bind_null")
 	purego.RegisterLibFunc(&db.BindBoolean, lib, "duckdb_bind_boolean")
@@ -218,32 +429,54 @@ func NewDB(path string) (*DB, error) {
 	purego.RegisterLibFunc(&db.BindDouble, lib, "duckdb_bind_double")
 	purego.RegisterLibFunc(&db.BindVarchar, lib, "duckdb_bind_varchar")
 	purego.RegisterLibFunc(&db.BindBlob, lib, "duckdb_bind_blob")
+	purego.RegisterLibFunc(&db.BindValue, lib, "duckdb_bind_value")
 	purego.RegisterLibFunc(&db.BindDate, lib, "duckdb_bind_date")
 	purego.RegisterLibFunc(&db.BindTime, lib, "duckdb_bind_time")
 	purego.RegisterLibFunc(&db.BindTimestamp, lib, "duckdb_bind_timestamp")
 
-	// Register error handling function
+	// Register error handling functions
 	purego.RegisterLibFunc(&db.ResultError, lib, "duckdb_result_error")
+	purego.RegisterLibFunc(&db.ResultErrorType, lib, "duckdb_result_error_type")
 
 	// Register Value interface functions
 	purego.RegisterLibFunc(&db.DestroyValue, lib, "duckdb_destroy_value")
 	purego.RegisterLibFunc(&db.CreateVarchar, lib, "duckdb_create_varchar")
 	purego.RegisterLibFunc(&db.CreateInt32, lib, "duckdb_create_int32")
 	purego.RegisterLibFunc(&db.CreateInt64, lib, "duckdb_create_int64")
+	purego.RegisterLibFunc(&db.CreateUint64, lib, "duckdb_create_uint64")
 	purego.RegisterLibFunc(&db.CreateDouble, lib, "duckdb_create_double")
 	purego.RegisterLibFunc(&db.CreateBool, lib, "duckdb_create_bool")
+	purego.RegisterLibFunc(&db.CreateTimestamp, lib, "duckdb_create_timestamp")
 	purego.RegisterLibFunc(&db.CreateListValue, lib, "duckdb_create_list_value")
+	purego.RegisterLibFunc(&db.CreateStructValue, lib, "duckdb_create_struct_value")
+	registerOptionalLibFunc(&db.CreateMapValue, lib, "duckdb_create_map_value")
 	purego.RegisterLibFunc(&db.GetListSize, lib, "duckdb_get_list_size")
 	purego.RegisterLibFunc(&db.GetListChild, lib, "duckdb_get_list_child")
 	purego.RegisterLibFunc(&db.IsNullValue, lib, "duckdb_is_null_value")
 	purego.RegisterLibFunc(&db.CreateNullValue, lib, "duckdb_create_null_value")
+	purego.RegisterLibFunc(&db.GetValueType, lib, "duckdb_get_value_type")
+	purego.RegisterLibFunc(&db.GetBool, lib, "duckdb_get_bool")
+	purego.RegisterLibFunc(&db.GetInt8, lib, "duckdb_get_int8")
+	purego.RegisterLibFunc(&db.GetInt16, lib, "duckdb_get_int16")
+	purego.RegisterLibFunc(&db.GetInt32, lib, "duckdb_get_int32")
+	purego.RegisterLibFunc(&db.GetInt64, lib, "duckdb_get_int64")
+	purego.RegisterLibFunc(&db.GetUint8, lib, "duckdb_get_uint8")
+	purego.RegisterLibFunc(&db.GetUint16, lib, "duckdb_get_uint16")
+	purego.RegisterLibFunc(&db.GetUint32, lib, "duckdb_get_uint32")
+	purego.RegisterLibFunc(&db.GetUint64, lib, "duckdb_get_uint64")
+	purego.RegisterLibFunc(&db.GetFloat, lib, "duckdb_get_float")
+	purego.RegisterLibFunc(&db.GetDouble, lib, "duckdb_get_double")
+	purego.RegisterLibFunc(&db.GetDate, lib, "duckdb_get_date")
+	purego.RegisterLibFunc(&db.GetTime, lib, "duckdb_get_time")
+	purego.RegisterLibFunc(&db.GetTimestamp, lib, "duckdb_get_timestamp")
+	purego.RegisterLibFunc(&db.GetVarchar, lib, "duckdb_get_varchar")
 
 	// Register Data Chunk interface functions
-	purego.RegisterLibFunc(&db.FetchChunk, lib, "duckdb_fetch_chunk")
-	purego.RegisterLibFunc(&db.ResultReturnType, lib, "duckdb_result_return_type")
-	purego.RegisterLibFunc(&db.ResultGetChunk, lib, "duckdb_result_get_chunk")
-	purego.RegisterLibFunc(&db.ResultChunkCount, lib, "duckdb_result_chunk_count")
-	purego.RegisterLibFunc(&db.ResultIsStreaming, lib, "duckdb_result_is_streaming")
+	// These take duckdb_result by value, which needs platform specific handling
+	registerResultFuncs(db, lib)
+	registerDecimalFuncs(db, lib)
+	registerIntervalFuncs(db, lib)
+	registerHugeintFuncs(db, lib)
 	purego.RegisterLibFunc(&db.CreateDataChunk, lib, "duckdb_create_data_chunk")
 	purego.RegisterLibFunc(&db.DestroyDataChunk, lib, "duckdb_destroy_data_chunk")
 	purego.RegisterLibFunc(&db.DataChunkReset, lib, "duckdb_data_chunk_reset")
@@ -270,11 +503,29 @@ func NewDB(path string) (*DB, error) {
 	purego.RegisterLibFunc(&db.CreateLogicalType, lib, "duckdb_create_logical_type")
 	purego.RegisterLibFunc(&db.LogicalTypeGetAlias, lib, "duckdb_logical_type_get_alias")
 	purego.RegisterLibFunc(&db.CreateListType, lib, "duckdb_create_list_type")
+	purego.RegisterLibFunc(&db.CreateStructType, lib, "duckdb_create_struct_type")
+	purego.RegisterLibFunc(&db.CreateMapType, lib, "duckdb_create_map_type")
 	purego.RegisterLibFunc(&db.ListTypeChildType, lib, "duckdb_list_type_child_type")
 	purego.RegisterLibFunc(&db.GetTypeID, lib, "duckdb_get_type_id")
 	purego.RegisterLibFunc(&db.DecimalWidth, lib, "duckdb_decimal_width")
 	purego.RegisterLibFunc(&db.DecimalScale, lib, "duckdb_decimal_scale")
 	purego.RegisterLibFunc(&db.DestroyLogicalType, lib, "duckdb_destroy_logical_type")
+	purego.RegisterLibFunc(&db.EnumInternalType, lib, "duckdb_enum_internal_type")
+	purego.RegisterLibFunc(&db.EnumDictionarySize, lib, "duckdb_enum_dictionary_size")
+	purego.RegisterLibFunc(&db.EnumDictionaryValue, lib, "duckdb_enum_dictionary_value")
+	purego.RegisterLibFunc(&db.StructTypeChildCount, lib, "duckdb_struct_type_child_count")
+	purego.RegisterLibFunc(&db.StructTypeChildName, lib, "duckdb_struct_type_child_name")
+	purego.RegisterLibFunc(&db.StructTypeChildType, lib, "duckdb_struct_type_child_type")
+	purego.RegisterLibFunc(&db.MapTypeKeyType, lib, "duckdb_map_type_key_type")
+	purego.RegisterLibFunc(&db.MapTypeValueType, lib, "duckdb_map_type_value_type")
+	purego.RegisterLibFunc(&db.ArrayTypeArraySize, lib, "duckdb_array_type_array_size")
+	purego.RegisterLibFunc(&db.ArrayTypeChildType, lib, "duckdb_array_type_child_type")
+	purego.RegisterLibFunc(&db.UnionTypeMemberCount, lib, "duckdb_union_type_member_count")
+	purego.RegisterLibFunc(&db.UnionTypeMemberName, lib, "duckdb_union_type_member_name")
+	purego.RegisterLibFunc(&db.UnionTypeMemberType, lib, "duckdb_union_type_member_type")
+
+	// Register memory management functions
+	purego.RegisterLibFunc(&db.Free, lib, "duckdb_free")
 
 	// Print library version
 	// version := db.LibraryVersion()
@@ -285,16 +536,69 @@ func NewDB(path string) (*DB, error) {
 	// }
 
 	// Open database
-	var handle DuckDBDatabase
-	state := open(path, &handle)
-	if state != DuckDBSuccess {
-		return nil, fmt.Errorf("failed to open database: %s", path)
+	handle, err := db.open(path, options)
+	if err != nil {
+		return nil, err
 	}
 	db.Handle = handle
 
 	return db, nil
 }
 
+// registerOptionalLibFunc registers a library function like purego.RegisterLibFunc,
+// leaving fptr nil if the library is too old to have it
+func registerOptionalLibFunc(fptr any, lib uintptr, name string) {
+	if _, err := purego.Dlsym(lib, name); err == nil {
+		purego.RegisterLibFunc(fptr, lib, name)
+	}
+}
+
+// open opens the database at path with the given configuration options
+func (db *DB) open(path string, options map[string]string) (DuckDBDatabase, error) {
+	var (
+		createConfig  func(*DuckDBConfig) DuckDBState
+		setConfig     func(DuckDBConfig, string, string) DuckDBState
+		destroyConfig func(*DuckDBConfig)
+		openExt       func(string, *DuckDBDatabase, DuckDBConfig, **byte) DuckDBState
+	)
+	purego.RegisterLibFunc(&createConfig, db.Lib, "duckdb_create_config")
+	purego.RegisterLibFunc(&setConfig, db.Lib, "duckdb_set_config")
+	purego.RegisterLibFunc(&destroyConfig, db.Lib, "duckdb_destroy_config")
+	purego.RegisterLibFunc(&openExt, db.Lib, "duckdb_open_ext")
+
+	var config DuckDBConfig
+	if state := createConfig(&config); state != DuckDBSuccess {
+		return nil, fmt.Errorf("failed to create database configuration")
+	}
+	// DuckDB copies the configuration when opening the database
+	defer destroyConfig(&config)
+
+	// Options are applied in a stable order, so that the first invalid one is always reported
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if state := setConfig(config, name, options[name]); state != DuckDBSuccess {
+			message := fmt.Sprintf("invalid configuration option %s=%q", name, options[name])
+			return nil, newError(DuckDBInvalidConfiguration, message, "")
+		}
+	}
+
+	var handle DuckDBDatabase
+	var cError *byte
+	if state := openExt(path, &handle, config, &cError); state != DuckDBSuccess {
+		message := "failed to open database: " + path
+		if cError != nil {
+			message = db.takeString(cError)
+		}
+		return nil, newError(DuckDBErrorInvalid, message, "")
+	}
+
+	return handle, nil
+}
+
 // CloseDB closes the database and releases resources
 func (db *DB) CloseDB() {
 	db.Close(&db.Handle)
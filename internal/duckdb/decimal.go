@@ -0,0 +1,203 @@
+package duckdb
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxDecimalWidth is the maximum number of digits of a DuckDB DECIMAL
+const maxDecimalWidth = 38
+
+// Decimal is an exact DECIMAL value, Unscaled / 10^Scale.
+// Width is the total number of digits and Scale the number of digits after the decimal point.
+type Decimal struct {
+	Width    uint8
+	Scale    uint8
+	Unscaled *big.Int
+}
+
+// ParseDecimal parses a decimal number like "-123.45".
+// Width and Scale are the smallest that hold the number exactly.
+func ParseDecimal(s string) (Decimal, error) {
+	text := strings.TrimSpace(s)
+	digits := strings.TrimLeft(text, "+-")
+	if len(text)-len(digits) > 1 {
+		return Decimal{}, errors.Errorf("invalid decimal %q", s)
+	}
+
+	whole, fraction, _ := strings.Cut(digits, ".")
+	if whole == "" && fraction == "" {
+		return Decimal{}, errors.Errorf("invalid decimal %q", s)
+	}
+	for _, c := range whole + fraction {
+		if c < '0' || c > '9' {
+			return Decimal{}, errors.Errorf("invalid decimal %q", s)
+		}
+	}
+	if len(fraction) > maxDecimalWidth {
+		return Decimal{}, errors.Errorf("decimal %q has more than %d digits", s, maxDecimalWidth)
+	}
+
+	unscaled, _ := new(big.Int).SetString("0"+whole+fraction, 10)
+	if strings.HasPrefix(text, "-") {
+		unscaled.Neg(unscaled)
+	}
+
+	return newDecimal(unscaled, uint8(len(fraction)))
+}
+
+// newDecimal creates a Decimal with the smallest width that holds unscaled
+func newDecimal(unscaled *big.Int, scale uint8) (Decimal, error) {
+	width := len(new(big.Int).Abs(unscaled).String())
+	width = max(width, int(scale), 1)
+	if width > maxDecimalWidth {
+		return Decimal{}, errors.Errorf("decimal %s has more than %d digits", formatDecimal(unscaled, int(scale)), maxDecimalWidth)
+	}
+
+	return Decimal{Width: uint8(width), Scale: scale, Unscaled: unscaled}, nil
+}
+
+// String returns the decimal in DuckDB's text representation, like "-123.45"
+func (d Decimal) String() string {
+	return formatDecimal(d.unscaled(), int(d.Scale))
+}
+
+// Float64 returns the nearest float64 to the decimal
+func (d Decimal) Float64() float64 {
+	f, _ := new(big.Rat).SetFrac(d.unscaled(), pow10(int(d.Scale))).Float64()
+	return f
+}
+
+// Value implements driver.Valuer.
+// The decimal is passed in its text representation, which DuckDB converts exactly.
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements sql.Scanner
+func (d *Decimal) Scan(src any) error {
+	if src == nil {
+		return errors.New("cannot scan NULL into Decimal")
+	}
+
+	decimal, err := toDecimal(src)
+	if err != nil {
+		return err
+	}
+	*d = decimal
+	return nil
+}
+
+// unscaled returns the unscaled value, treating nil as zero
+func (d Decimal) unscaled() *big.Int {
+	if d.Unscaled == nil {
+		return new(big.Int)
+	}
+	return d.Unscaled
+}
+
+// rescale returns the unscaled value for the given scale.
+// Reducing the scale fails unless the removed digits are zero.
+func (d Decimal) rescale(scale uint8) (*big.Int, error) {
+	unscaled := d.unscaled()
+	if scale >= d.Scale {
+		return new(big.Int).Mul(unscaled, pow10(int(scale-d.Scale))), nil
+	}
+
+	quotient, remainder := new(big.Int).QuoRem(unscaled, pow10(int(d.Scale-scale)), new(big.Int))
+	if remainder.Sign() != 0 {
+		return nil, errors.Errorf("decimal %s does not fit scale %d", d, scale)
+	}
+	return quotient, nil
+}
+
+// toDecimal converts a Go value to a Decimal without going through float64,
+// except for float values themselves, which use their shortest exact representation
+func toDecimal(value any) (Decimal, error) {
+	switch v := value.(type) {
+	case Decimal:
+		return v, nil
+	case *Decimal:
+		return *v, nil
+	case string:
+		return ParseDecimal(v)
+	case []byte:
+		return ParseDecimal(string(v))
+	case *big.Int:
+		return newDecimal(new(big.Int).Set(v), 0)
+	case int:
+		return newDecimal(big.NewInt(int64(v)), 0)
+	case int8:
+		return newDecimal(big.NewInt(int64(v)), 0)
+	case int16:
+		return newDecimal(big.NewInt(int64(v)), 0)
+	case int32:
+		return newDecimal(big.NewInt(int64(v)), 0)
+	case int64:
+		return newDecimal(big.NewInt(v), 0)
+	case uint:
+		return newDecimal(new(big.Int).SetUint64(uint64(v)), 0)
+	case uint8:
+		return newDecimal(big.NewInt(int64(v)), 0)
+	case uint16:
+		return newDecimal(big.NewInt(int64(v)), 0)
+	case uint32:
+		return newDecimal(big.NewInt(int64(v)), 0)
+	case uint64:
+		return newDecimal(new(big.Int).SetUint64(v), 0)
+	case float32:
+		return floatToDecimal(float64(v), 32)
+	case float64:
+		return floatToDecimal(v, 64)
+	case fmt.Stringer:
+		return ParseDecimal(v.String())
+	default:
+		return Decimal{}, errors.Errorf("cannot convert %T to DECIMAL", value)
+	}
+}
+
+// floatToDecimal converts a float to the shortest decimal that parses back to it
+func floatToDecimal(f float64, bitSize int) (Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}, errors.Errorf("cannot convert %v to DECIMAL", f)
+	}
+	return ParseDecimal(strconv.FormatFloat(f, 'f', -1, bitSize))
+}
+
+// pow10 returns 10^n
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// decimal mirrors duckdb_decimal
+type decimal struct {
+	width uint8
+	scale uint8
+	value hugeint
+}
+
+// toC converts the decimal to the struct passed to DuckDB
+func (d Decimal) toC() decimal {
+	lower, upper := bigToHugeint(d.unscaled())
+	return decimal{
+		width: d.Width,
+		scale: d.Scale,
+		value: hugeint{lower: lower, upper: upper},
+	}
+}
+
+// bigToHugeint splits a big.Int that fits in 128 bits into the words of a hugeint
+func bigToHugeint(n *big.Int) (lower, upper uint64) {
+	// Two's complement of the lower 128 bits
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	u := new(big.Int).And(n, mask)
+	lower = new(big.Int).And(u, new(big.Int).SetUint64(math.MaxUint64)).Uint64()
+	upper = new(big.Int).Rsh(u, 64).Uint64()
+	return lower, upper
+}
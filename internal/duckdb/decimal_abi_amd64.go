@@ -0,0 +1,22 @@
+//go:build amd64 && !windows
+
+package duckdb
+
+import (
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+)
+
+// registerDecimalFuncs registers the functions that take a duckdb_decimal by value.
+//
+// Like duckdb_result, the 24 byte duckdb_decimal is passed on the stack, so its
+// three words follow the integer registers filled with the arguments and padding.
+func registerDecimalFuncs(db *DB, lib uintptr) {
+	var bindDecimal func(ps DuckDBPreparedStatement, idx int32, _, _, _, _, d0, d1, d2 uintptr) DuckDBState
+	purego.RegisterLibFunc(&bindDecimal, lib, "duckdb_bind_decimal")
+	db.BindDecimal = func(ps DuckDBPreparedStatement, idx int32, d *decimal) DuckDBState {
+		w := *(*[3]uintptr)(unsafe.Pointer(d))
+		return bindDecimal(ps, idx, 0, 0, 0, 0, w[0], w[1], w[2])
+	}
+}
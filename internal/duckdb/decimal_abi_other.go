@@ -0,0 +1,15 @@
+//go:build !amd64 || windows
+
+package duckdb
+
+import (
+	"github.com/ebitengine/purego"
+)
+
+// registerDecimalFuncs registers the functions that take a duckdb_decimal by value.
+//
+// On arm64 and on Windows, the 24 byte duckdb_decimal is passed as a pointer
+// to a caller-owned copy.
+func registerDecimalFuncs(db *DB, lib uintptr) {
+	purego.RegisterLibFunc(&db.BindDecimal, lib, "duckdb_bind_decimal")
+}
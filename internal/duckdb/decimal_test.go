@@ -0,0 +1,161 @@
+package duckdb
+
+import (
+	"math/big"
+	"testing"
+	"unsafe"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		input    string
+		width    uint8
+		scale    uint8
+		unscaled string
+		text     string
+		wantErr  bool
+	}{
+		{input: "123.45", width: 5, scale: 2, unscaled: "12345", text: "123.45"},
+		{input: "-0.001", width: 3, scale: 3, unscaled: "-1", text: "-0.001"},
+		{input: "+7", width: 1, scale: 0, unscaled: "7", text: "7"},
+		{input: ".5", width: 1, scale: 1, unscaled: "5", text: "0.5"},
+		{input: "100.", width: 3, scale: 0, unscaled: "100", text: "100"},
+		{
+			input:    "12345678901234567890.123456789012345678",
+			width:    38,
+			scale:    18,
+			unscaled: "12345678901234567890123456789012345678",
+			text:     "12345678901234567890.123456789012345678",
+		},
+		{input: "123456789012345678901234567890123456789", wantErr: true},
+		{input: "1.2.3", wantErr: true},
+		{input: "--1", wantErr: true},
+		{input: "1e5", wantErr: true},
+		{input: ".", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := ParseDecimal(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseDecimal(%q) = %v, want error", tt.input, d)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDecimal(%q) failed: %v", tt.input, err)
+			}
+			if d.Width != tt.width || d.Scale != tt.scale || d.Unscaled.String() != tt.unscaled {
+				t.Errorf("ParseDecimal(%q) = (%d, %d, %s), want (%d, %d, %s)",
+					tt.input, d.Width, d.Scale, d.Unscaled, tt.width, tt.scale, tt.unscaled)
+			}
+			if d.String() != tt.text {
+				t.Errorf("String() = %q, want %q", d.String(), tt.text)
+			}
+		})
+	}
+}
+
+func TestDecimalScan(t *testing.T) {
+	tests := []struct {
+		src     any
+		want    string
+		wantErr bool
+	}{
+		{src: Decimal{Width: 10, Scale: 2, Unscaled: big.NewInt(-1050)}, want: "-10.50"},
+		{src: "19.99", want: "19.99"},
+		{src: []byte("0.10"), want: "0.10"},
+		{src: int64(42), want: "42"},
+		{src: 0.1, want: "0.1"},
+		{src: nil, wantErr: true},
+		{src: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var d Decimal
+		err := d.Scan(tt.src)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Scan(%v) = %v, want error", tt.src, d)
+			}
+			continue
+		}
+		if err != nil || d.String() != tt.want {
+			t.Errorf("Scan(%v) = %v, %v, want %s", tt.src, d, err, tt.want)
+		}
+	}
+
+	// The zero value is zero
+	if got := (Decimal{}).String(); got != "0" {
+		t.Errorf("zero Decimal = %q, want 0", got)
+	}
+}
+
+func TestDecimalRescale(t *testing.T) {
+	d, _ := ParseDecimal("12.30")
+	if got, err := d.rescale(4); err != nil || got.String() != "123000" {
+		t.Errorf("rescale(4) = %v, %v, want 123000", got, err)
+	}
+	if got, err := d.rescale(1); err != nil || got.String() != "123" {
+		t.Errorf("rescale(1) = %v, %v, want 123", got, err)
+	}
+	if _, err := d.rescale(0); err == nil {
+		t.Errorf("rescale(0) succeeded, want error for lost digits")
+	}
+}
+
+func TestBigToHugeint(t *testing.T) {
+	for _, s := range []string{"0", "1", "-1", "18446744073709551616", "-170141183460469231731687303715884105728"} {
+		n, _ := new(big.Int).SetString(s, 10)
+		lower, upper := bigToHugeint(n)
+		if got := hugeintToBig(lower, int64(upper)); got.Cmp(n) != 0 {
+			t.Errorf("round trip of %s = %s", s, got)
+		}
+	}
+
+	// duckdb_decimal is passed by value as three words
+	if size := unsafe.Sizeof(decimal{}); size != 24 {
+		t.Errorf("size of decimal = %d, want 24", size)
+	}
+}
+
+func TestVectorDecimal(t *testing.T) {
+	tests := []struct {
+		name   string
+		width  uint8
+		vector func() *Vector
+	}{
+		{"smallint", 4, func() *Vector { return testVector(DuckDBTypeDecimal, make([]int16, 1), nil) }},
+		{"integer", 9, func() *Vector { return testVector(DuckDBTypeDecimal, make([]int32, 1), nil) }},
+		{"bigint", 18, func() *Vector { return testVector(DuckDBTypeDecimal, make([]int64, 1), nil) }},
+		{"hugeint", 38, func() *Vector { return testVector(DuckDBTypeDecimal, make([]hugeint, 1), nil) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := tt.vector()
+			v.reader.width = tt.width
+			v.reader.scale = 2
+
+			if err := v.SetValue(0, "-12.5"); err != nil {
+				t.Fatalf("SetValue failed: %v", err)
+			}
+			got, ok := v.Value(0).(Decimal)
+			if !ok || got.String() != "-12.50" || got.Width != tt.width || got.Scale != 2 {
+				t.Errorf("Value(0) = %#v, want -12.50", v.Value(0))
+			}
+
+			if err := v.SetValue(0, "0.125"); err == nil {
+				t.Errorf("SetValue succeeded for a value that does not fit the scale")
+			}
+		})
+	}
+
+	v := testVector(DuckDBTypeDecimal, make([]int16, 1), nil)
+	v.reader.width, v.reader.scale = 4, 2
+	if err := v.SetValue(0, "123.45"); err == nil {
+		t.Errorf("SetValue succeeded for a value that does not fit the width")
+	}
+}
@@ -0,0 +1,78 @@
+package duckdb
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// enumDictionary returns the values of an ENUM type, in the order of their index
+func enumDictionary(db *DB, logicalType DuckDBLogicalType) []string {
+	dict := make([]string, db.EnumDictionarySize(logicalType))
+	for i := range dict {
+		dict[i] = db.takeString(db.EnumDictionaryValue(logicalType, int64(i)))
+	}
+	return dict
+}
+
+// enumValueIndex returns the dictionary index of an ENUM value
+func enumValueIndex(dict []string, value string) (int, error) {
+	for i, v := range dict {
+		if v == value {
+			return i, nil
+		}
+	}
+	return 0, errors.Errorf("invalid ENUM value %q, expected one of %s", value, formatEnumValues(dict))
+}
+
+// formatEnumValues renders ENUM values as a list of SQL strings, like 'a', 'b'
+func formatEnumValues(dict []string) string {
+	quoted := make([]string, len(dict))
+	for i, v := range dict {
+		quoted[i] = "'" + strings.ReplaceAll(v, "'", "''") + "'"
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// EnumValues returns the values of an ENUM column, or nil for other types
+func (vr *ValueReader) EnumValues() []string {
+	if vr.typeID != DuckDBTypeEnum {
+		return nil
+	}
+	return append([]string(nil), vr.enumDict...)
+}
+
+// TypeName returns the SQL name of the reader's type, like "INTEGER",
+// "DECIMAL(18,3)" or "ENUM('a', 'b')". Aliases like JSON are returned as such.
+func (vr *ValueReader) TypeName() string {
+	if vr.alias != "" {
+		return vr.alias
+	}
+	switch vr.typeID {
+	case DuckDBTypeEnum:
+		return "ENUM(" + formatEnumValues(vr.enumDict) + ")"
+	case DuckDBTypeDecimal:
+		return "DECIMAL(" + strconv.Itoa(int(vr.width)) + "," + strconv.Itoa(int(vr.scale)) + ")"
+	case DuckDBTypeList:
+		return vr.children[0].TypeName() + "[]"
+	case DuckDBTypeArray:
+		return vr.children[0].TypeName() + "[" + strconv.Itoa(vr.arraySize) + "]"
+	case DuckDBTypeMap:
+		return "MAP(" + vr.children[0].TypeName() + ", " + vr.children[1].TypeName() + ")"
+	case DuckDBTypeStruct:
+		children := make([]string, len(vr.children))
+		for i, child := range vr.children {
+			children[i] = formatIdentifier(vr.childNames[i]) + " " + child.TypeName()
+		}
+		return "STRUCT(" + strings.Join(children, ", ") + ")"
+	case DuckDBTypeUnion:
+		members := make([]string, len(vr.children))
+		for i, child := range vr.children {
+			members[i] = formatIdentifier(vr.childNames[i]) + " " + child.TypeName()
+		}
+		return "UNION(" + strings.Join(members, ", ") + ")"
+	default:
+		return vr.typeID.String()
+	}
+}
@@ -0,0 +1,72 @@
+package duckdb
+
+import (
+	"testing"
+)
+
+func TestVectorEnum(t *testing.T) {
+	tests := []struct {
+		name     string
+		enumType DuckDBType
+		vector   func() *Vector
+	}{
+		{"utinyint", DuckDBTypeUTinyint, func() *Vector { return testVector(DuckDBTypeEnum, make([]uint8, 2), nil) }},
+		{"usmallint", DuckDBTypeUSmallint, func() *Vector { return testVector(DuckDBTypeEnum, make([]uint16, 2), nil) }},
+		{"uinteger", DuckDBTypeUInteger, func() *Vector { return testVector(DuckDBTypeEnum, make([]uint32, 2), nil) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := tt.vector()
+			v.reader.enumType = tt.enumType
+			v.reader.enumDict = []string{"active", "closed"}
+
+			if err := v.SetValue(1, "closed"); err != nil {
+				t.Fatalf("SetValue failed: %v", err)
+			}
+			if got := v.Value(0); got != "active" {
+				t.Errorf("Value(0) = %v, want active", got)
+			}
+			if got := v.Value(1); got != "closed" {
+				t.Errorf("Value(1) = %v, want closed", got)
+			}
+			if err := v.SetValue(0, "deleted"); err == nil {
+				t.Errorf("SetValue succeeded for a value that is not in the dictionary")
+			}
+		})
+	}
+}
+
+func TestValueReaderTypeName(t *testing.T) {
+	tests := []struct {
+		reader   *ValueReader
+		expected string
+	}{
+		{&ValueReader{typeID: DuckDBTypeInteger}, "INTEGER"},
+		{&ValueReader{typeID: DuckDBTypeDecimal, width: 18, scale: 3}, "DECIMAL(18,3)"},
+		{&ValueReader{typeID: DuckDBTypeVarchar, alias: "JSON"}, "JSON"},
+		{&ValueReader{typeID: DuckDBTypeEnum, enumDict: []string{"a", "it's"}}, "ENUM('a', 'it''s')"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.reader.TypeName(); got != tt.expected {
+			t.Errorf("TypeName() = %v, want %v", got, tt.expected)
+		}
+	}
+
+	if values := (&ValueReader{typeID: DuckDBTypeVarchar}).EnumValues(); values != nil {
+		t.Errorf("EnumValues() = %v for VARCHAR, want nil", values)
+	}
+}
+
+func TestEnumValueIndex(t *testing.T) {
+	dict := []string{"active", "closed"}
+	if index, err := enumValueIndex(dict, "closed"); err != nil || index != 1 {
+		t.Errorf("enumValueIndex(closed) = %d, %v, want 1", index, err)
+	}
+
+	_, err := enumValueIndex(dict, "Active")
+	if err == nil || err.Error() != `invalid ENUM value "Active", expected one of 'active', 'closed'` {
+		t.Errorf("enumValueIndex(Active) error = %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package duckdb
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Error represents an error reported by DuckDB while preparing or executing SQL
+type Error struct {
+	// Type is DuckDB's classification of the error
+	Type DuckDBErrorType
+	// Message is the error message as reported by DuckDB
+	Message string
+	// SQL is the statement that caused the error
+	SQL string
+	// Position is the byte offset of the error in SQL, or -1 if DuckDB did not report one
+	Position int
+}
+
+// Error returns the DuckDB error message
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is reports whether target is an *Error of the same type.
+// A target without a message matches every error of its type, so
+// errors.Is(err, &Error{Type: DuckDBErrorConstraint}) detects constraint violations.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	if t.Type != e.Type {
+		return false
+	}
+	return t.Message == "" || t.Message == e.Message
+}
+
+// newError creates an Error for a failed statement.
+// If errType is DuckDBErrorInvalid, the type is derived from the message prefix.
+func newError(errType DuckDBErrorType, message, sql string) *Error {
+	if errType == DuckDBErrorInvalid {
+		errType = parseErrorType(message)
+	}
+
+	return &Error{
+		Type:     errType,
+		Message:  message,
+		SQL:      sql,
+		Position: errorPosition(message, sql),
+	}
+}
+
+// resultError creates an Error from a failed result
+func (db *DB) resultError(raw *DuckDBResultRaw, sql string) *Error {
+	var message string
+	if db.ResultError != nil {
+		message = GoString(db.ResultError(raw))
+	}
+
+	errType := DuckDBErrorInvalid
+	if db.ResultErrorType != nil {
+		errType = db.ResultErrorType(raw)
+	}
+
+	if message == "" {
+		message = "failed to execute query"
+	}
+
+	return newError(errType, message, sql)
+}
+
+// parseErrorType derives the error type from a message like "Parser Error: ..."
+func parseErrorType(message string) DuckDBErrorType {
+	prefix, _, found := strings.Cut(message, " Error: ")
+	if !found {
+		return DuckDBErrorInvalid
+	}
+
+	for t, name := range errorTypeNames {
+		if strings.EqualFold(name, prefix) {
+			return t
+		}
+	}
+
+	return DuckDBErrorInvalid
+}
+
+// errorContextPattern matches the context DuckDB appends to positional errors:
+//
+//	LINE 1: SELEC 1
+//	        ^
+var errorContextPattern = regexp.MustCompile(`(?m)^LINE (\d+): (.*)\n( *)\^`)
+
+// errorPosition returns the byte offset in sql that the error message points to,
+// or -1 if the message does not contain a position.
+func errorPosition(message, sql string) int {
+	m := errorContextPattern.FindStringSubmatch(message)
+	if m == nil || sql == "" {
+		return -1
+	}
+
+	line, err := strconv.Atoi(m[1])
+	if err != nil || line < 1 {
+		return -1
+	}
+	snippet := m[2]
+	column := len(m[3]) - len("LINE "+m[1]+": ")
+
+	// Find the start of the reported line
+	lineStart := 0
+	for i := 1; i < line; i++ {
+		next := strings.IndexByte(sql[lineStart:], '\n')
+		if next < 0 {
+			return -1
+		}
+		lineStart += next + 1
+	}
+	sqlLine := sql[lineStart:]
+	if end := strings.IndexByte(sqlLine, '\n'); end >= 0 {
+		sqlLine = sqlLine[:end]
+	}
+
+	// Long lines are shortened to a window around the error, marked with "..."
+	if strings.HasPrefix(snippet, "...") {
+		shown := strings.TrimSuffix(snippet[len("..."):], "...")
+		offset := strings.Index(sqlLine, shown)
+		if offset < 0 {
+			return -1
+		}
+		column = offset + column - len("...")
+	}
+
+	if column < 0 || column > len(sqlLine) {
+		return -1
+	}
+
+	return lineStart + column
+}
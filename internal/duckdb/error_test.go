@@ -0,0 +1,121 @@
+package duckdb
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestParseErrorType(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		expected DuckDBErrorType
+	}{
+		{
+			name:     "parser error",
+			message:  "Parser Error: syntax error at or near \"SELEC\"",
+			expected: DuckDBErrorParser,
+		},
+		{
+			name:     "catalog error",
+			message:  "Catalog Error: Table with name missing does not exist!",
+			expected: DuckDBErrorCatalog,
+		},
+		{
+			name:     "constraint error",
+			message:  "Constraint Error: Duplicate key \"id: 1\" violates primary key constraint.",
+			expected: DuckDBErrorConstraint,
+		},
+		{
+			name:     "transaction error",
+			message:  "TransactionContext Error: Catalog write-write conflict on create with \"t\"",
+			expected: DuckDBErrorTransaction,
+		},
+		{
+			name:     "multi-word prefix",
+			message:  "Invalid Input Error: bad input",
+			expected: DuckDBErrorInvalidInput,
+		},
+		{
+			name:     "no prefix",
+			message:  "something went wrong",
+			expected: DuckDBErrorInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseErrorType(tt.message); got != tt.expected {
+				t.Errorf("parseErrorType() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestErrorPosition(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		sql      string
+		expected int
+	}{
+		{
+			name:     "first line",
+			message:  "Parser Error: syntax error at or near \"SELEC\"\n\nLINE 1: SELEC 1\n        ^",
+			sql:      "SELEC 1",
+			expected: 0,
+		},
+		{
+			name:     "inside first line",
+			message:  "Binder Error: Referenced column \"x\" not found\n\nLINE 1: SELECT x FROM t\n               ^",
+			sql:      "SELECT x FROM t",
+			expected: 7,
+		},
+		{
+			name:     "second line",
+			message:  "Binder Error: Referenced column \"x\" not found\n\nLINE 2: WHERE x = 1\n              ^",
+			sql:      "SELECT *\nWHERE x = 1",
+			expected: 15,
+		},
+		{
+			name:     "shortened line",
+			message:  "Parser Error: syntax error\n\nLINE 1: ...c, d FROM t WHER x = 1\n                       ^",
+			sql:      "SELECT a, b, c, d FROM t WHER x = 1",
+			expected: 25,
+		},
+		{
+			name:     "no position",
+			message:  "Catalog Error: Table with name missing does not exist!",
+			sql:      "SELECT * FROM missing",
+			expected: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorPosition(tt.message, tt.sql); got != tt.expected {
+				t.Errorf("errorPosition() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestErrorIs(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", newError(DuckDBErrorInvalid, "Constraint Error: NOT NULL constraint failed", "INSERT"))
+
+	if !errors.Is(err, &Error{Type: DuckDBErrorConstraint}) {
+		t.Errorf("Expected error to match constraint type")
+	}
+	if errors.Is(err, &Error{Type: DuckDBErrorParser}) {
+		t.Errorf("Expected error not to match parser type")
+	}
+
+	var duckErr *Error
+	if !errors.As(err, &duckErr) {
+		t.Fatalf("Expected errors.As to find *Error")
+	}
+	if duckErr.SQL != "INSERT" {
+		t.Errorf("Expected SQL 'INSERT', got %q", duckErr.SQL)
+	}
+}
@@ -0,0 +1,210 @@
+package duckdb
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// text renders the value at the given row the way DuckDB casts it to VARCHAR.
+// It is used for types that are not decoded into a dedicated Go type.
+func (v *Vector) text(row int) string {
+	if v.IsNull(row) {
+		return "NULL"
+	}
+
+	vr := v.reader
+	switch vr.typeID {
+	case DuckDBTypeDate:
+		return formatTimestamp(dateFromDays(get[int32](v.data, row)), "2006-01-02")
+	case DuckDBTypeTime:
+		return timeFromMicros(get[int64](v.data, row)).Format("15:04:05.999999")
+	case DuckDBTypeTimestamp:
+		return formatTimestamp(timestampFromMicros(get[int64](v.data, row)), "2006-01-02 15:04:05.999999")
+	case DuckDBTypeTimestampS:
+		return formatTimestamp(timestampFromUnits(get[int64](v.data, row), vr.typeID), "2006-01-02 15:04:05")
+	case DuckDBTypeTimestampMS:
+		return formatTimestamp(timestampFromUnits(get[int64](v.data, row), vr.typeID), "2006-01-02 15:04:05.999")
+	case DuckDBTypeTimestampNS:
+		return formatTimestamp(timestampFromUnits(get[int64](v.data, row), vr.typeID), "2006-01-02 15:04:05.999999999")
+	case DuckDBTypeTimestampTZ:
+		return formatTimestamp(timestampFromMicros(get[int64](v.data, row)), "2006-01-02 15:04:05.999999-07")
+	case DuckDBTypeTimeTZ:
+		micros, offset := splitTimeTZ(get[uint64](v.data, row))
+		return time.UnixMicro(micros).UTC().Format("15:04:05.999999") + formatOffset(offset)
+	case DuckDBTypeInterval:
+		return get[Interval](v.data, row).String()
+	case DuckDBTypeHugeint:
+		h := get[hugeint](v.data, row)
+		return hugeintToBig(h.lower, int64(h.upper)).String()
+	case DuckDBTypeUHugeint:
+		h := get[hugeint](v.data, row)
+		return uhugeintToBig(h.lower, h.upper).String()
+	case DuckDBTypeUUID:
+		return uuidFromHugeint(get[hugeint](v.data, row)).String()
+	case DuckDBTypeDecimal:
+		return formatDecimal(v.decimalUnscaled(row), int(vr.scale))
+	case DuckDBTypeVarchar:
+		return string(stringBytes(v.data, row))
+	case DuckDBTypeBlob:
+		return formatBlob(stringBytes(v.data, row))
+	case DuckDBTypeBit:
+		return bitStringFromStorage(stringBytes(v.data, row)).String()
+	case DuckDBTypeVarInt:
+		return varintToBig(stringBytes(v.data, row)).String()
+	case DuckDBTypeEnum:
+		return vr.enumDict[v.enumIndex(row)]
+	case DuckDBTypeList:
+		entry := get[listEntry](v.data, row)
+		return v.children[0].joinText(int(entry.offset), int(entry.length))
+	case DuckDBTypeArray:
+		return v.children[0].joinText(row*vr.arraySize, vr.arraySize)
+	case DuckDBTypeMap:
+		entry := get[listEntry](v.data, row)
+		parts := make([]string, entry.length)
+		for i := range parts {
+			idx := int(entry.offset) + i
+			parts[i] = v.children[0].text(idx) + "=" + v.children[1].text(idx)
+		}
+		return "{" + strings.Join(parts, ", ") + "}"
+	case DuckDBTypeStruct:
+		parts := make([]string, len(v.children))
+		for i, child := range v.children {
+			parts[i] = "'" + vr.childNames[i] + "': " + child.text(row)
+		}
+		return "{" + strings.Join(parts, ", ") + "}"
+	case DuckDBTypeUnion:
+		tag := get[uint8](v.tags, row)
+		return v.children[tag].text(row)
+	default:
+		return fmt.Sprint(v.Value(row))
+	}
+}
+
+// joinText renders length child values starting at offset as a DuckDB list
+func (v *Vector) joinText(offset, length int) string {
+	parts := make([]string, length)
+	for i := range parts {
+		parts[i] = v.text(offset + i)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// decimalUnscaled returns the unscaled integer value of a DECIMAL
+func (v *Vector) decimalUnscaled(row int) *big.Int {
+	// The physical storage of a DECIMAL depends on its width
+	switch width := v.reader.width; {
+	case width <= 4:
+		return big.NewInt(int64(get[int16](v.data, row)))
+	case width <= 9:
+		return big.NewInt(int64(get[int32](v.data, row)))
+	case width <= 18:
+		return big.NewInt(get[int64](v.data, row))
+	default:
+		h := get[hugeint](v.data, row)
+		return hugeintToBig(h.lower, int64(h.upper))
+	}
+}
+
+// enumIndex returns the dictionary index of the ENUM value at the given row
+func (v *Vector) enumIndex(row int) int {
+	switch v.reader.enumType {
+	case DuckDBTypeUTinyint:
+		return int(get[uint8](v.data, row))
+	case DuckDBTypeUSmallint:
+		return int(get[uint16](v.data, row))
+	default:
+		return int(get[uint32](v.data, row))
+	}
+}
+
+// hugeintToBig converts a signed 128-bit integer to a big.Int
+func hugeintToBig(lower uint64, upper int64) *big.Int {
+	n := big.NewInt(upper)
+	n.Lsh(n, 64)
+	return n.Add(n, new(big.Int).SetUint64(lower))
+}
+
+// uhugeintToBig converts an unsigned 128-bit integer to a big.Int
+func uhugeintToBig(lower, upper uint64) *big.Int {
+	n := new(big.Int).SetUint64(upper)
+	n.Lsh(n, 64)
+	return n.Add(n, new(big.Int).SetUint64(lower))
+}
+
+// formatDecimal renders an unscaled decimal value with the given scale
+func formatDecimal(unscaled *big.Int, scale int) string {
+	digits := new(big.Int).Abs(unscaled).String()
+	sign := ""
+	if unscaled.Sign() < 0 {
+		sign = "-"
+	}
+	if scale == 0 {
+		return sign + digits
+	}
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+}
+
+// formatBlob renders a BLOB like DuckDB, escaping non-printable bytes as \xNN
+func formatBlob(b []byte) string {
+	var sb strings.Builder
+	for _, c := range b {
+		if c >= 32 && c <= 126 && c != '\\' && c != '\'' && c != '"' {
+			sb.WriteByte(c)
+		} else {
+			fmt.Fprintf(&sb, "\\x%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+// varintToBig decodes DuckDB's VARINT encoding.
+// A 3 byte header holds the sign in its top bit and the data length,
+// followed by the big-endian magnitude. Negative numbers have all bits inverted.
+func varintToBig(b []byte) *big.Int {
+	if len(b) < 3 {
+		return new(big.Int)
+	}
+	negative := b[0]&0x80 == 0
+	data := append([]byte(nil), b[3:]...)
+	if negative {
+		for i := range data {
+			data[i] = ^data[i]
+		}
+	}
+	n := new(big.Int).SetBytes(data)
+	if negative {
+		n.Neg(n)
+	}
+	return n
+}
+
+// splitTimeTZ decodes a TIME WITH TIME ZONE into microseconds and the UTC offset in seconds.
+// The upper 40 bits hold the microseconds and the lower 24 bits the inverted offset.
+func splitTimeTZ(bits uint64) (micros int64, offset int) {
+	const maxOffset = 16*60*60 - 1
+	micros = int64(bits >> 24)
+	offset = maxOffset - int(bits&0xFFFFFF)
+	return micros, offset
+}
+
+// formatOffset renders a UTC offset in seconds like DuckDB, e.g. "+02" or "-05:30"
+func formatOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	s := fmt.Sprintf("%s%02d", sign, offset/3600)
+	if offset%3600 != 0 {
+		s += fmt.Sprintf(":%02d", offset/60%60)
+		if offset%60 != 0 {
+			s += fmt.Sprintf(":%02d", offset%60)
+		}
+	}
+	return s
+}
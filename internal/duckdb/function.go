@@ -0,0 +1,94 @@
+package duckdb
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ebitengine/purego"
+)
+
+// functionRegistry holds the Go functions registered with DuckDB and the
+// state of their calls. C code must not keep Go pointers, so DuckDB refers
+// to these objects by the ids stored as extra info, bind data and init data.
+var functionRegistry = struct {
+	sync.RWMutex
+	nextID  uintptr
+	objects map[uintptr]any
+	// db provides the DuckDB functions called from callbacks.
+	// The library is loaded once per process, so these are the same for every DB.
+	db *DB
+}{
+	objects: map[uintptr]any{},
+}
+
+// callbacks are the C function pointers shared by all registered functions.
+// purego callbacks are never freed, so they are created once and dispatch by id.
+var callbacks struct {
+	once          sync.Once
+	scalar        uintptr
+	tableBind     uintptr
+	tableInit     uintptr
+	tableFunction uintptr
+	deleteData    uintptr
+}
+
+// initCallbacks creates the C callbacks on first use
+func initCallbacks() {
+	callbacks.once.Do(func() {
+		callbacks.scalar = purego.NewCallback(scalarFunctionCallback)
+		callbacks.tableBind = purego.NewCallback(tableBindCallback)
+		callbacks.tableInit = purego.NewCallback(tableInitCallback)
+		callbacks.tableFunction = purego.NewCallback(tableFunctionCallback)
+		callbacks.deleteData = purego.NewCallback(unregister)
+	})
+}
+
+// register stores obj in the registry and returns its id
+func register(db *DB, obj any) uintptr {
+	functionRegistry.Lock()
+	defer functionRegistry.Unlock()
+
+	if functionRegistry.db == nil {
+		functionRegistry.db = db
+	}
+	// Ids start at 1, so that missing data is never a valid id
+	functionRegistry.nextID++
+	id := functionRegistry.nextID
+	functionRegistry.objects[id] = obj
+	return id
+}
+
+// lookup returns the registered object with the given id
+func lookup(id uintptr) any {
+	functionRegistry.RLock()
+	defer functionRegistry.RUnlock()
+	return functionRegistry.objects[id]
+}
+
+// callbackDB returns the DB used to call back into DuckDB
+func callbackDB() *DB {
+	functionRegistry.RLock()
+	defer functionRegistry.RUnlock()
+	return functionRegistry.db
+}
+
+// unregister removes an object from the registry and releases its resources.
+// DuckDB calls it when the extra info, bind data or init data holding the id is destroyed.
+func unregister(id uintptr) {
+	functionRegistry.Lock()
+	obj := functionRegistry.objects[id]
+	delete(functionRegistry.objects, id)
+	functionRegistry.Unlock()
+
+	if c, ok := obj.(interface{ close() }); ok {
+		c.close()
+	}
+}
+
+// recoverError turns a panic of a Go function called by DuckDB into an error,
+// as a panic must not unwind through C frames
+func recoverError(name string, err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("panic in function %s: %v", name, r)
+	}
+}
@@ -0,0 +1,133 @@
+package duckdb
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// Ranges of the 128-bit integer types
+var (
+	minHugeint  = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+	maxHugeint  = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	maxUHugeint = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+)
+
+// HugeInt is an integer of any size, as read from HUGEINT, UHUGEINT and VARINT columns.
+// A nil Int is zero.
+type HugeInt struct {
+	Int *big.Int
+}
+
+// String returns the integer in base 10
+func (h HugeInt) String() string {
+	return h.value().String()
+}
+
+// Value implements driver.Valuer.
+// The integer is passed in its text representation, which DuckDB converts exactly.
+func (h HugeInt) Value() (driver.Value, error) {
+	return h.String(), nil
+}
+
+// Scan implements sql.Scanner
+func (h *HugeInt) Scan(src any) error {
+	if src == nil {
+		return errors.New("cannot scan NULL into HugeInt")
+	}
+
+	n, err := toBigInt(src)
+	if err != nil {
+		return err
+	}
+	h.Int = n
+	return nil
+}
+
+// value returns the integer, treating nil as zero
+func (h HugeInt) value() *big.Int {
+	if h.Int == nil {
+		return new(big.Int)
+	}
+	return h.Int
+}
+
+// toBigInt converts a Go integer, or its text representation, to a new big.Int
+func toBigInt(value any) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return new(big.Int).Set(v), nil
+	case HugeInt:
+		return new(big.Int).Set(v.value()), nil
+	case *HugeInt:
+		return new(big.Int).Set(v.value()), nil
+	case int:
+		return big.NewInt(int64(v)), nil
+	case int8:
+		return big.NewInt(int64(v)), nil
+	case int16:
+		return big.NewInt(int64(v)), nil
+	case int32:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	case uint:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint8:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint16:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint32:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	case string:
+		return parseBigInt(v)
+	case []byte:
+		return parseBigInt(string(v))
+	case fmt.Stringer:
+		return parseBigInt(v.String())
+	default:
+		return nil, errors.Errorf("cannot convert %T to an integer", value)
+	}
+}
+
+// parseBigInt parses a base 10 integer
+func parseBigInt(s string) (*big.Int, error) {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid integer %q", s)
+	}
+	return n, nil
+}
+
+// toHugeint converts n to a HUGEINT, or a UHUGEINT if unsigned, checking its range
+func toHugeint(n *big.Int, unsigned bool) (hugeint, error) {
+	if unsigned && (n.Sign() < 0 || n.Cmp(maxUHugeint) > 0) {
+		return hugeint{}, errors.Errorf("value %s out of range for UHUGEINT", n)
+	}
+	if !unsigned && (n.Cmp(minHugeint) < 0 || n.Cmp(maxHugeint) > 0) {
+		return hugeint{}, errors.Errorf("value %s out of range for HUGEINT", n)
+	}
+	lower, upper := bigToHugeint(n)
+	return hugeint{lower: lower, upper: upper}, nil
+}
+
+// bigToVarint encodes n in DuckDB's VARINT encoding, the inverse of varintToBig
+func bigToVarint(n *big.Int) []byte {
+	data := n.Bytes()
+	if len(data) == 0 {
+		data = []byte{0}
+	}
+
+	header := uint32(len(data)) | 0x800000
+	b := append([]byte{byte(header >> 16), byte(header >> 8), byte(header)}, data...)
+	if n.Sign() < 0 {
+		for i := range b {
+			b[i] = ^b[i]
+		}
+	}
+	return b
+}
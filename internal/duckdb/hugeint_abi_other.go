@@ -0,0 +1,23 @@
+//go:build !windows
+
+package duckdb
+
+import (
+	"github.com/ebitengine/purego"
+)
+
+// registerHugeintFuncs registers the functions that take a duckdb_hugeint by value.
+//
+// The System V amd64 and the arm64 ABIs pass the 16 byte duckdb_hugeint in two
+// integer registers, the same way as two separate words.
+func registerHugeintFuncs(db *DB, lib uintptr) {
+	var bindHugeint, bindUHugeint func(ps DuckDBPreparedStatement, idx int32, lower, upper uint64) DuckDBState
+	purego.RegisterLibFunc(&bindHugeint, lib, "duckdb_bind_hugeint")
+	purego.RegisterLibFunc(&bindUHugeint, lib, "duckdb_bind_uhugeint")
+	db.BindHugeint = func(ps DuckDBPreparedStatement, idx int32, h *hugeint) DuckDBState {
+		return bindHugeint(ps, idx, h.lower, h.upper)
+	}
+	db.BindUHugeint = func(ps DuckDBPreparedStatement, idx int32, h *hugeint) DuckDBState {
+		return bindUHugeint(ps, idx, h.lower, h.upper)
+	}
+}
@@ -0,0 +1,15 @@
+//go:build windows
+
+package duckdb
+
+import (
+	"github.com/ebitengine/purego"
+)
+
+// registerHugeintFuncs registers the functions that take a duckdb_hugeint by value.
+//
+// On Windows, structs larger than 8 bytes are passed as a pointer to a caller-owned copy.
+func registerHugeintFuncs(db *DB, lib uintptr) {
+	purego.RegisterLibFunc(&db.BindHugeint, lib, "duckdb_bind_hugeint")
+	purego.RegisterLibFunc(&db.BindUHugeint, lib, "duckdb_bind_uhugeint")
+}
@@ -0,0 +1,123 @@
+package duckdb
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestHugeIntScan(t *testing.T) {
+	big128, _ := new(big.Int).SetString("170141183460469231731687303715884105727", 10)
+
+	tests := []struct {
+		src      any
+		expected string
+		wantErr  bool
+	}{
+		{src: big128, expected: "170141183460469231731687303715884105727"},
+		{src: int64(-42), expected: "-42"},
+		{src: uint64(18446744073709551615), expected: "18446744073709551615"},
+		{src: "-123456789012345678901234567890", expected: "-123456789012345678901234567890"},
+		{src: []byte("7"), expected: "7"},
+		{src: "1.5", wantErr: true},
+		{src: 1.0, wantErr: true},
+		{src: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var h HugeInt
+		err := h.Scan(tt.src)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Scan(%v) = %v, want error", tt.src, h)
+			}
+			continue
+		}
+		if err != nil || h.String() != tt.expected {
+			t.Errorf("Scan(%v) = %v, %v, want %v", tt.src, h, err, tt.expected)
+		}
+	}
+
+	// Scanned integers are copies
+	var h HugeInt
+	if err := h.Scan(big128); err != nil || h.Int == big128 {
+		t.Errorf("Scan(*big.Int) did not copy the integer")
+	}
+	if (HugeInt{}).String() != "0" {
+		t.Errorf("String() of the zero HugeInt = %v, want 0", HugeInt{})
+	}
+}
+
+func TestToHugeint(t *testing.T) {
+	tests := []struct {
+		value    string
+		unsigned bool
+		wantErr  bool
+	}{
+		{value: "-170141183460469231731687303715884105728"},
+		{value: "170141183460469231731687303715884105727"},
+		{value: "170141183460469231731687303715884105728", wantErr: true},
+		{value: "-170141183460469231731687303715884105729", wantErr: true},
+		{value: "340282366920938463463374607431768211455", unsigned: true},
+		{value: "340282366920938463463374607431768211456", unsigned: true, wantErr: true},
+		{value: "-1", unsigned: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		n, _ := new(big.Int).SetString(tt.value, 10)
+		h, err := toHugeint(n, tt.unsigned)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("toHugeint(%s, %v) succeeded, want error", tt.value, tt.unsigned)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("toHugeint(%s, %v) failed: %v", tt.value, tt.unsigned, err)
+			continue
+		}
+
+		got := hugeintToBig(h.lower, int64(h.upper))
+		if tt.unsigned {
+			got = uhugeintToBig(h.lower, h.upper)
+		}
+		if got.Cmp(n) != 0 {
+			t.Errorf("toHugeint(%s, %v) round trip = %s", tt.value, tt.unsigned, got)
+		}
+	}
+}
+
+func TestBigToVarint(t *testing.T) {
+	for _, s := range []string{"0", "1", "-1", "258", "-258", "-99999999999999999999999999999999999999999999"} {
+		n, _ := new(big.Int).SetString(s, 10)
+		if got := varintToBig(bigToVarint(n)); got.Cmp(n) != 0 {
+			t.Errorf("round trip of %s = %s", s, got)
+		}
+	}
+}
+
+func TestVectorHugeint(t *testing.T) {
+	hugeints := testVector(DuckDBTypeHugeint, make([]hugeint, 2), nil)
+	if err := hugeints.SetValue(0, "-170141183460469231731687303715884105728"); err != nil {
+		t.Fatalf("SetValue failed: %v", err)
+	}
+	if err := hugeints.SetValue(1, int64(-1)); err != nil {
+		t.Fatalf("SetValue failed: %v", err)
+	}
+	if got, ok := hugeints.Value(0).(*big.Int); !ok || got.String() != "-170141183460469231731687303715884105728" {
+		t.Errorf("Value(0) = %v, want the minimum HUGEINT", hugeints.Value(0))
+	}
+	if got, ok := hugeints.Value(1).(*big.Int); !ok || got.Int64() != -1 {
+		t.Errorf("Value(1) = %v, want -1", hugeints.Value(1))
+	}
+
+	uhugeints := testVector(DuckDBTypeUHugeint, make([]hugeint, 1), nil)
+	if err := uhugeints.SetValue(0, "340282366920938463463374607431768211455"); err != nil {
+		t.Fatalf("SetValue failed: %v", err)
+	}
+	if got, ok := uhugeints.Value(0).(*big.Int); !ok || got.String() != "340282366920938463463374607431768211455" {
+		t.Errorf("Value(0) = %v, want the maximum UHUGEINT", uhugeints.Value(0))
+	}
+	if err := uhugeints.SetValue(0, -1); err == nil {
+		t.Errorf("SetValue(-1) succeeded for UHUGEINT, want error")
+	}
+}
@@ -0,0 +1,186 @@
+package duckdb
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Interval is a DuckDB INTERVAL. Months, days and microseconds are kept
+// separately, as the length of a month or a day depends on the date it is added to.
+// It mirrors duckdb_interval.
+type Interval struct {
+	Months int32
+	Days   int32
+	Micros int64
+}
+
+// IntervalFromDuration converts a duration to an interval of microseconds.
+// Fractions of a microsecond are truncated.
+func IntervalFromDuration(d time.Duration) Interval {
+	return Interval{Micros: d.Microseconds()}
+}
+
+// Duration returns the length of the interval, counting a day as 24 hours
+// and a month as 30 days, like DuckDB's epoch function
+func (iv Interval) Duration() time.Duration {
+	days := int64(iv.Months)*30 + int64(iv.Days)
+	return time.Duration(days)*24*time.Hour + time.Duration(iv.Micros)*time.Microsecond
+}
+
+// String renders the interval like DuckDB, e.g. "1 year 2 months 3 days 04:05:06"
+func (iv Interval) String() string {
+	var parts []string
+	plural := func(n int64, unit string) {
+		if n == 0 {
+			return
+		}
+		if n == 1 || n == -1 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, unit))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d %ss", n, unit))
+		}
+	}
+	plural(int64(iv.Months/12), "year")
+	plural(int64(iv.Months%12), "month")
+	plural(int64(iv.Days), "day")
+
+	if iv.Micros != 0 || len(parts) == 0 {
+		micros := iv.Micros
+		sign := ""
+		if micros < 0 {
+			sign = "-"
+			micros = -micros
+		}
+		clock := fmt.Sprintf("%s%02d:%02d:%02d", sign,
+			micros/3_600_000_000, micros/60_000_000%60, micros/1_000_000%60)
+		if frac := micros % 1_000_000; frac != 0 {
+			clock += strings.TrimRight(fmt.Sprintf(".%06d", frac), "0")
+		}
+		parts = append(parts, clock)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Value implements driver.Valuer.
+// The interval is passed in its text representation, which DuckDB converts exactly.
+func (iv Interval) Value() (driver.Value, error) {
+	return iv.String(), nil
+}
+
+// Scan implements sql.Scanner
+func (iv *Interval) Scan(src any) error {
+	if src == nil {
+		return errors.New("cannot scan NULL into Interval")
+	}
+
+	interval, err := toInterval(src)
+	if err != nil {
+		return err
+	}
+	*iv = interval
+	return nil
+}
+
+// ParseInterval parses an interval in DuckDB's text representation,
+// like "1 year 2 months 3 days 04:05:06.5"
+func ParseInterval(s string) (Interval, error) {
+	var iv Interval
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return Interval{}, errors.Errorf("invalid interval %q", s)
+	}
+
+	for i := 0; i < len(fields); i++ {
+		if strings.Contains(fields[i], ":") {
+			if i != len(fields)-1 {
+				return Interval{}, errors.Errorf("invalid interval %q", s)
+			}
+			micros, err := parseClock(fields[i])
+			if err != nil {
+				return Interval{}, errors.Wrapf(err, "invalid interval %q", s)
+			}
+			iv.Micros += micros
+			continue
+		}
+
+		if i+1 >= len(fields) {
+			return Interval{}, errors.Errorf("invalid interval %q", s)
+		}
+		n, err := strconv.ParseInt(fields[i], 10, 32)
+		if err != nil {
+			return Interval{}, errors.Wrapf(err, "invalid interval %q", s)
+		}
+		i++
+		switch strings.TrimSuffix(strings.ToLower(fields[i]), "s") {
+		case "year":
+			iv.Months += int32(n) * 12
+		case "month", "mon":
+			iv.Months += int32(n)
+		case "day":
+			iv.Days += int32(n)
+		default:
+			return Interval{}, errors.Errorf("invalid interval unit %q in %q", fields[i], s)
+		}
+	}
+
+	return iv, nil
+}
+
+// parseClock parses a time of an interval like "-04:05:06.5" into microseconds
+func parseClock(s string) (int64, error) {
+	negative := strings.HasPrefix(s, "-")
+	parts := strings.Split(strings.TrimPrefix(s, "-"), ":")
+	if len(parts) != 3 {
+		return 0, errors.Errorf("invalid time %q", s)
+	}
+
+	seconds, fraction, _ := strings.Cut(parts[2], ".")
+	if len(fraction) > 6 {
+		return 0, errors.Errorf("invalid time %q", s)
+	}
+
+	hours, errH := strconv.ParseUint(parts[0], 10, 32)
+	minutes, errM := strconv.ParseUint(parts[1], 10, 8)
+	secs, errS := strconv.ParseUint(seconds, 10, 8)
+	frac, errF := strconv.ParseUint((fraction + "000000")[:6], 10, 32)
+	if errH != nil || errM != nil || errS != nil || errF != nil || minutes >= 60 || secs >= 60 {
+		return 0, errors.Errorf("invalid time %q", s)
+	}
+
+	micros := int64(hours)*3_600_000_000 + int64(minutes)*60_000_000 + int64(secs)*1_000_000 + int64(frac)
+	if negative {
+		micros = -micros
+	}
+	return micros, nil
+}
+
+// toInterval converts a Go value to an Interval.
+// Integers are taken as nanoseconds, which is how database/sql passes a time.Duration.
+func toInterval(value any) (Interval, error) {
+	switch v := value.(type) {
+	case Interval:
+		return v, nil
+	case *Interval:
+		return *v, nil
+	case time.Duration:
+		return IntervalFromDuration(v), nil
+	case int64:
+		return IntervalFromDuration(time.Duration(v)), nil
+	case int:
+		return IntervalFromDuration(time.Duration(v)), nil
+	case int32:
+		return IntervalFromDuration(time.Duration(v)), nil
+	case string:
+		return ParseInterval(v)
+	case []byte:
+		return ParseInterval(string(v))
+	default:
+		return Interval{}, errors.Errorf("cannot convert %T to INTERVAL", value)
+	}
+}
@@ -0,0 +1,22 @@
+//go:build !windows
+
+package duckdb
+
+import (
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+)
+
+// registerIntervalFuncs registers the functions that take a duckdb_interval by value.
+//
+// The System V amd64 and the arm64 ABIs pass the 16 byte duckdb_interval in two
+// integer registers, the same way as two separate words.
+func registerIntervalFuncs(db *DB, lib uintptr) {
+	var bindInterval func(ps DuckDBPreparedStatement, idx int32, i0, i1 uint64) DuckDBState
+	purego.RegisterLibFunc(&bindInterval, lib, "duckdb_bind_interval")
+	db.BindInterval = func(ps DuckDBPreparedStatement, idx int32, iv *Interval) DuckDBState {
+		w := *(*[2]uint64)(unsafe.Pointer(iv))
+		return bindInterval(ps, idx, w[0], w[1])
+	}
+}
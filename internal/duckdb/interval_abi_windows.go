@@ -0,0 +1,14 @@
+//go:build windows
+
+package duckdb
+
+import (
+	"github.com/ebitengine/purego"
+)
+
+// registerIntervalFuncs registers the functions that take a duckdb_interval by value.
+//
+// On Windows, structs larger than 8 bytes are passed as a pointer to a caller-owned copy.
+func registerIntervalFuncs(db *DB, lib uintptr) {
+	purego.RegisterLibFunc(&db.BindInterval, lib, "duckdb_bind_interval")
+}
@@ -0,0 +1,102 @@
+package duckdb
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIntervalString(t *testing.T) {
+	tests := []struct {
+		input    Interval
+		expected string
+	}{
+		{Interval{Months: 14, Days: 3, Micros: 4*3_600_000_000 + 5*60_000_000 + 6_000_000}, "1 year 2 months 3 days 04:05:06"},
+		{Interval{Days: 1}, "1 day"},
+		{Interval{Months: -1, Days: -2}, "-1 month -2 days"},
+		{Interval{Micros: -1_500_000}, "-00:00:01.5"},
+		{Interval{Micros: 100 * 3_600_000_000}, "100:00:00"},
+		{Interval{}, "00:00:00"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.String(); got != tt.expected {
+			t.Errorf("String(%v) = %v, want %v", tt.input, got, tt.expected)
+		}
+
+		// The text representation parses back to the same interval
+		parsed, err := ParseInterval(tt.expected)
+		if err != nil || parsed != tt.input {
+			t.Errorf("ParseInterval(%q) = %v, %v, want %v", tt.expected, parsed, err, tt.input)
+		}
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Interval
+		wantErr bool
+	}{
+		{input: "2 Years 1 mon", want: Interval{Months: 25}},
+		{input: "3 days 00:00:00.000001", want: Interval{Days: 3, Micros: 1}},
+		{input: "", wantErr: true},
+		{input: "3", wantErr: true},
+		{input: "3 weeks", wantErr: true},
+		{input: "00:61:00", wantErr: true},
+		{input: "00:00:00 1 day", wantErr: true},
+		{input: "00:00:00.1234567", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseInterval(tt.input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseInterval(%q) = %v, want error", tt.input, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseInterval(%q) = %v, %v, want %v", tt.input, got, err, tt.want)
+		}
+	}
+}
+
+func TestIntervalDuration(t *testing.T) {
+	iv := IntervalFromDuration(90*time.Minute + 1500*time.Nanosecond)
+	if iv != (Interval{Micros: 5_400_000_001}) {
+		t.Errorf("IntervalFromDuration() = %v", iv)
+	}
+
+	iv = Interval{Months: 1, Days: 1, Micros: 1}
+	if got, want := iv.Duration(), 31*24*time.Hour+time.Microsecond; got != want {
+		t.Errorf("Duration() = %v, want %v", got, want)
+	}
+
+	var scanned Interval
+	if err := scanned.Scan(int64(2 * time.Second)); err != nil || scanned != (Interval{Micros: 2_000_000}) {
+		t.Errorf("Scan(2s) = %v, %v", scanned, err)
+	}
+	if err := scanned.Scan([]byte("1 day")); err != nil || scanned != (Interval{Days: 1}) {
+		t.Errorf("Scan(1 day) = %v, %v", scanned, err)
+	}
+	if err := scanned.Scan(nil); err == nil {
+		t.Errorf("Scan(nil) succeeded")
+	}
+}
+
+func TestVectorInterval(t *testing.T) {
+	v := testVector(DuckDBTypeInterval, make([]Interval, 2), nil)
+	if err := v.SetValue(0, 36*time.Hour); err != nil {
+		t.Fatalf("SetValue failed: %v", err)
+	}
+	if err := v.SetValue(1, "1 year 2 days"); err != nil {
+		t.Fatalf("SetValue failed: %v", err)
+	}
+
+	if got := v.Value(0); got != (Interval{Micros: 36 * 3_600_000_000}) {
+		t.Errorf("Value(0) = %v", got)
+	}
+	if got := v.Value(1); got != (Interval{Months: 12, Days: 2}) {
+		t.Errorf("Value(1) = %v", got)
+	}
+}
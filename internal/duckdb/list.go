@@ -0,0 +1,127 @@
+package duckdb
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// List is a DuckDB LIST read into a slice of T.
+// Elements convert to T like columns in database/sql, so a LIST of INTEGER
+// can be scanned into a List[int64]. NULL lists scan as a nil List.
+type List[T any] []T
+
+// Scan implements sql.Scanner
+func (l *List[T]) Scan(src any) error {
+	if src == nil {
+		*l = nil
+		return nil
+	}
+
+	out, err := assign(src, reflect.TypeOf([]T(nil)))
+	if err != nil {
+		return errors.Wrapf(err, "cannot scan %T into List", src)
+	}
+	*l = out.Interface().([]T)
+	return nil
+}
+
+// listValue decodes length child values starting at offset into a slice.
+// The slice has the Go type of the child type, or is a []any when it holds
+// NULL elements or values of different types.
+func (v *Vector) listValue(offset, length int) any {
+	values := make([]any, length)
+	for i := range values {
+		values[i] = v.children[0].Value(offset + i)
+	}
+	return typedSlice(values, v.children[0].reader.GoType())
+}
+
+// typedSlice copies values into a slice of elemType when they all have that type
+func typedSlice(values []any, elemType reflect.Type) any {
+	if elemType == nil || elemType.Kind() == reflect.Interface {
+		return values
+	}
+	for _, value := range values {
+		if value == nil || reflect.TypeOf(value) != elemType {
+			return values
+		}
+	}
+
+	out := reflect.MakeSlice(reflect.SliceOf(elemType), len(values), len(values))
+	for i, value := range values {
+		out.Index(i).Set(reflect.ValueOf(value))
+	}
+	return out.Interface()
+}
+
+// createListValue creates a LIST value from a slice or array, converting elements for
+// elemType. The LIST has the type of its first non-NULL element, and is cast by DuckDB
+// to the parameter type. Without an element type, empty lists take it from the Go type.
+func (db *DB) createListValue(v reflect.Value, elemType DuckDBLogicalType) (DuckDBValue, error) {
+	if (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) || v.Type().Elem().Kind() == reflect.Uint8 {
+		return nil, errors.Errorf("cannot convert %s to LIST, expected a slice or array", v.Type())
+	}
+
+	values := make([]DuckDBValue, v.Len())
+	defer db.destroyValues(values)
+	for i := range values {
+		value, err := db.createValue(v.Index(i).Interface(), elemType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "element %d", i)
+		}
+		values[i] = value
+	}
+
+	listType := elemType
+	for _, value := range values {
+		if !db.IsNullValue(value) {
+			listType = db.GetValueType(value)
+			break
+		}
+	}
+	if listType == nil {
+		goType := db.goLogicalType(v.Type().Elem())
+		defer db.DestroyLogicalType(&goType)
+		listType = goType
+	}
+
+	var first *DuckDBValue
+	if len(values) > 0 {
+		first = &values[0]
+	}
+	list := db.CreateListValue(listType, first, int64(len(values)))
+	if list == nil {
+		return nil, errors.New("failed to create LIST value")
+	}
+	return list, nil
+}
+
+// goLogicalType returns the logical type of values created from Go values of type t
+// without a parameter type. The caller destroys the type.
+func (db *DB) goLogicalType(t reflect.Type) DuckDBLogicalType {
+	for t.Kind() == reflect.Pointer && t != bigIntType {
+		t = t.Elem()
+	}
+	if t == reflect.TypeOf(time.Time{}) {
+		return db.CreateLogicalType(DuckDBTypeTimestamp)
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return db.CreateLogicalType(DuckDBTypeBoolean)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return db.CreateLogicalType(DuckDBTypeBigint)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return db.CreateLogicalType(DuckDBTypeUBigint)
+	case reflect.Float32, reflect.Float64:
+		return db.CreateLogicalType(DuckDBTypeDouble)
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() != reflect.Uint8 {
+			child := db.goLogicalType(t.Elem())
+			defer db.DestroyLogicalType(&child)
+			return db.CreateListType(child)
+		}
+	}
+	return db.CreateLogicalType(DuckDBTypeVarchar)
+}
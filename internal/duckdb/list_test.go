@@ -0,0 +1,124 @@
+package duckdb
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testListVector creates a LIST vector of entries over a child vector
+func testListVector(entries []listEntry, child *Vector) *Vector {
+	v := testVector(DuckDBTypeList, entries, nil)
+	v.reader.children = []*ValueReader{child.reader}
+	v.children = []*Vector{child}
+	return v
+}
+
+func TestVectorList(t *testing.T) {
+	bigints := testVector(DuckDBTypeBigint, []int64{1, 2, 3, 0}, []uint64{0b0111})
+	v := testListVector([]listEntry{{0, 3}, {3, 0}, {2, 2}}, bigints)
+
+	if got := v.Value(0); !reflect.DeepEqual(got, []int64{1, 2, 3}) {
+		t.Errorf("Value(0) = %#v, want []int64{1, 2, 3}", got)
+	}
+	if got := v.Value(1); !reflect.DeepEqual(got, []int64{}) {
+		t.Errorf("Value(1) = %#v, want an empty []int64", got)
+	}
+	// NULL elements are kept in a []any
+	if got := v.Value(2); !reflect.DeepEqual(got, []any{int64(3), nil}) {
+		t.Errorf("Value(2) = %#v, want []any{3, nil}", got)
+	}
+	if got := v.text(0); got != "[1, 2, 3]" {
+		t.Errorf("text(0) = %v, want [1, 2, 3]", got)
+	}
+	if got := v.reader.GoType(); got != reflect.TypeOf([]int64{}) {
+		t.Errorf("GoType() = %v, want []int64", got)
+	}
+	if got := v.reader.TypeName(); got != "BIGINT[]" {
+		t.Errorf("TypeName() = %v, want BIGINT[]", got)
+	}
+
+	// Nested lists decode recursively
+	nested := testListVector([]listEntry{{0, 2}}, testListVector([]listEntry{{0, 2}, {2, 1}}, bigints))
+	if got := nested.Value(0); !reflect.DeepEqual(got, [][]int64{{1, 2}, {3}}) {
+		t.Errorf("Value(0) = %#v, want [][]int64{{1, 2}, {3}}", got)
+	}
+}
+
+func TestListScan(t *testing.T) {
+	var ints List[int64]
+	if err := ints.Scan([]int32{1, 2}); err != nil || !reflect.DeepEqual(ints, List[int64]{1, 2}) {
+		t.Errorf("Scan([]int32) = %v, %v, want [1 2]", ints, err)
+	}
+	if err := ints.Scan(nil); err != nil || ints != nil {
+		t.Errorf("Scan(nil) = %v, %v, want nil", ints, err)
+	}
+	if err := ints.Scan([]any{int64(1), nil}); err == nil {
+		t.Errorf("Scan() of a NULL element into int64 succeeded, want error")
+	}
+	if err := ints.Scan([]int64{1 << 40}); err != nil {
+		t.Errorf("Scan() failed: %v", err)
+	}
+
+	var small List[int8]
+	if err := small.Scan([]int64{1 << 40}); err == nil {
+		t.Errorf("Scan() of an out of range value succeeded, want error")
+	}
+
+	// Pointer elements tell NULL apart
+	var pointers List[*string]
+	if err := pointers.Scan([]any{"a", nil}); err != nil || len(pointers) != 2 || *pointers[0] != "a" || pointers[1] != nil {
+		t.Errorf("Scan() into *string = %v, %v, want [a <nil>]", pointers, err)
+	}
+
+	var nested List[List[string]]
+	if err := nested.Scan([][]string{{"a"}, nil}); err != nil || !reflect.DeepEqual(nested, List[List[string]]{{"a"}, nil}) {
+		t.Errorf("Scan([][]string) = %v, %v", nested, err)
+	}
+
+	var decimals List[Decimal]
+	if err := decimals.Scan([]any{"1.50", int64(2)}); err != nil || decimals[0].String() != "1.50" || decimals[1].String() != "2" {
+		t.Errorf("Scan() into Decimal = %v, %v", decimals, err)
+	}
+	if err := decimals.Scan("not a list"); err == nil {
+		t.Errorf("Scan(string) succeeded, want error")
+	}
+}
+
+func TestAssign(t *testing.T) {
+	type tag string
+
+	tests := []struct {
+		value    any
+		target   any
+		expected any
+		wantErr  bool
+	}{
+		{value: int32(7), target: int64(0), expected: int64(7)},
+		{value: "42", target: uint16(0), expected: uint16(42)},
+		{value: int64(-1), target: uint8(0), wantErr: true},
+		{value: 1.5, target: float32(0), expected: float32(1.5)},
+		{value: 1.5, target: int(0), wantErr: true},
+		{value: int16(3), target: "", expected: "3"},
+		{value: []byte("ab"), target: "", expected: "ab"},
+		{value: "ab", target: []byte(nil), expected: []byte("ab")},
+		{value: "a", target: tag(""), expected: tag("a")},
+		{value: "true", target: false, expected: true},
+		{value: int64(5), target: (*int64)(nil), expected: func() *int64 { n := int64(5); return &n }()},
+		{value: nil, target: []int(nil), expected: []int(nil)},
+		{value: nil, target: 0, wantErr: true},
+		{value: []any{"1", int8(2)}, target: []int(nil), expected: []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		got, err := assign(tt.value, reflect.TypeOf(tt.target))
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("assign(%#v, %T) = %v, want error", tt.value, tt.target, got)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(got.Interface(), tt.expected) {
+			t.Errorf("assign(%#v, %T) = %v, %v, want %#v", tt.value, tt.target, got, err, tt.expected)
+		}
+	}
+}
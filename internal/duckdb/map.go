@@ -0,0 +1,205 @@
+package duckdb
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MapEntry is a key and value of a DuckDB MAP.
+// MAP values whose keys cannot be Go map keys are read as a []MapEntry, in order.
+type MapEntry struct {
+	Key   any
+	Value any
+}
+
+// Map is a DuckDB MAP read into a Go map.
+// Keys and values convert to K and V like columns in database/sql,
+// so a MAP(INTEGER, DOUBLE) can be scanned into a Map[int64, float64].
+// NULL maps scan as a nil Map.
+type Map[K comparable, V any] map[K]V
+
+// Scan implements sql.Scanner
+func (m *Map[K, V]) Scan(src any) error {
+	if src == nil {
+		*m = nil
+		return nil
+	}
+
+	out, err := assign(src, reflect.TypeOf(map[K]V(nil)))
+	if err != nil {
+		return errors.Wrapf(err, "cannot scan %T into Map", src)
+	}
+	*m = out.Interface().(map[K]V)
+	return nil
+}
+
+// mapValue decodes length MAP entries starting at offset.
+// Entries are returned as a Go map when the key type can be compared like DuckDB
+// compares keys, and as a []MapEntry otherwise.
+func (v *Vector) mapValue(offset, length int) any {
+	keys, values := v.children[0], v.children[1]
+	keyType := keys.reader.GoType()
+	if !mapKeyType(keyType) {
+		entries := make([]MapEntry, length)
+		for i := range entries {
+			entries[i] = MapEntry{Key: keys.Value(offset + i), Value: values.Value(offset + i)}
+		}
+		return entries
+	}
+
+	// Values have the Go type of the value type, or any when some are NULL
+	valueType := values.reader.GoType()
+	decoded := make([]any, length)
+	for i := range decoded {
+		decoded[i] = values.Value(offset + i)
+		if valueType != nil && (decoded[i] == nil || reflect.TypeOf(decoded[i]) != valueType) {
+			valueType = nil
+		}
+	}
+	if valueType == nil {
+		valueType = reflect.TypeOf((*any)(nil)).Elem()
+	}
+
+	out := reflect.MakeMapWithSize(reflect.MapOf(keyType, valueType), length)
+	for i, value := range decoded {
+		elem := reflect.Zero(valueType)
+		if value != nil {
+			elem = reflect.ValueOf(value)
+		}
+		out.SetMapIndex(reflect.ValueOf(keys.Value(offset+i)), elem)
+	}
+	return out.Interface()
+}
+
+// mapKeyType reports whether values of t are equal as Go map keys exactly when
+// they are equal in DuckDB. Types holding pointers, such as *big.Int, are not.
+func mapKeyType(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	if t == reflect.TypeOf(time.Time{}) {
+		// Decoded times share the location of their column
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Array:
+		return mapKeyType(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !mapKeyType(t.Field(i).Type) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// createMapValue creates a MAP value from a Go map or a []MapEntry.
+// The MAP has the types of its first key and first non-NULL value,
+// and is cast by DuckDB to the parameter type.
+// Without a MAP type, empty maps take their types from the Go type.
+func (db *DB) createMapValue(v reflect.Value, mapType DuckDBLogicalType) (DuckDBValue, error) {
+	if db.CreateMapValue == nil {
+		return nil, errors.New("binding MAP values needs duckdb_create_map_value, which the DuckDB library does not have")
+	}
+
+	var keys, values []any
+	switch {
+	case v.Kind() == reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			keys = append(keys, iter.Key().Interface())
+			values = append(values, iter.Value().Interface())
+		}
+	case v.Type() == reflect.TypeOf([]MapEntry(nil)):
+		for _, entry := range v.Interface().([]MapEntry) {
+			keys = append(keys, entry.Key)
+			values = append(values, entry.Value)
+		}
+	default:
+		return nil, errors.Errorf("cannot convert %s to MAP, expected a map or []MapEntry", v.Type())
+	}
+
+	var keyType, valueType DuckDBLogicalType
+	if mapType != nil {
+		keyType, valueType = db.MapTypeKeyType(mapType), db.MapTypeValueType(mapType)
+		defer db.DestroyLogicalType(&keyType)
+		defer db.DestroyLogicalType(&valueType)
+	}
+
+	keyValues := make([]DuckDBValue, len(keys))
+	defer db.destroyValues(keyValues)
+	valueValues := make([]DuckDBValue, len(values))
+	defer db.destroyValues(valueValues)
+	for i := range keys {
+		if keys[i] == nil {
+			return nil, errors.New("MAP keys cannot be NULL")
+		}
+		key, err := db.createValue(keys[i], keyType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "key %v", keys[i])
+		}
+		keyValues[i] = key
+		value, err := db.createValue(values[i], valueType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "value of key %v", keys[i])
+		}
+		valueValues[i] = value
+	}
+
+	entryKeyType, entryValueType := keyType, valueType
+	if len(keyValues) > 0 {
+		entryKeyType = db.GetValueType(keyValues[0])
+	}
+	for _, value := range valueValues {
+		if !db.IsNullValue(value) {
+			entryValueType = db.GetValueType(value)
+			break
+		}
+	}
+	if entryKeyType == nil {
+		goType := db.mapGoLogicalType(v.Type(), true)
+		defer db.DestroyLogicalType(&goType)
+		entryKeyType = goType
+	}
+	if entryValueType == nil {
+		goType := db.mapGoLogicalType(v.Type(), false)
+		defer db.DestroyLogicalType(&goType)
+		entryValueType = goType
+	}
+
+	valueMapType := db.CreateMapType(entryKeyType, entryValueType)
+	defer db.DestroyLogicalType(&valueMapType)
+	var firstKey, firstValue *DuckDBValue
+	if len(keyValues) > 0 {
+		firstKey, firstValue = &keyValues[0], &valueValues[0]
+	}
+	value := db.CreateMapValue(valueMapType, firstKey, firstValue, int64(len(keyValues)))
+	if value == nil {
+		return nil, errors.New("failed to create MAP value")
+	}
+	return value, nil
+}
+
+// mapGoLogicalType returns the logical type of the keys or values of a Go map type
+// without a parameter type. The caller destroys the type.
+func (db *DB) mapGoLogicalType(t reflect.Type, key bool) DuckDBLogicalType {
+	switch {
+	case t.Kind() != reflect.Map:
+		// Entries of a []MapEntry can hold anything
+		return db.CreateLogicalType(DuckDBTypeVarchar)
+	case key:
+		return db.goLogicalType(t.Key())
+	default:
+		return db.goLogicalType(t.Elem())
+	}
+}
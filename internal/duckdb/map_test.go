@@ -0,0 +1,100 @@
+package duckdb
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+// testMapVector creates a MAP vector of entries over key and value vectors
+func testMapVector(entries []listEntry, keys, values *Vector) *Vector {
+	v := testVector(DuckDBTypeMap, entries, nil)
+	v.reader.children = []*ValueReader{keys.reader, values.reader}
+	v.children = []*Vector{keys, values}
+	return v
+}
+
+func TestVectorMap(t *testing.T) {
+	keys := testVector(DuckDBTypeInteger, []int32{1, 2, 3}, nil)
+	values := testVector(DuckDBTypeDouble, []float64{0.5, 1.5, 0}, []uint64{0b011})
+	v := testMapVector([]listEntry{{0, 2}, {2, 1}, {0, 0}}, keys, values)
+
+	if got := v.Value(0); !reflect.DeepEqual(got, map[int32]float64{1: 0.5, 2: 1.5}) {
+		t.Errorf("Value(0) = %#v, want map[int32]float64", got)
+	}
+	// NULL values are kept in a map[K]any
+	if got := v.Value(1); !reflect.DeepEqual(got, map[int32]any{3: nil}) {
+		t.Errorf("Value(1) = %#v, want map[int32]any{3: nil}", got)
+	}
+	if got := v.Value(2); !reflect.DeepEqual(got, map[int32]float64{}) {
+		t.Errorf("Value(2) = %#v, want an empty map", got)
+	}
+	if got := v.text(0); got != "{1=0.5, 2=1.5}" {
+		t.Errorf("text(0) = %v", got)
+	}
+	if got := v.reader.GoType(); got != reflect.TypeOf(map[int32]float64{}) {
+		t.Errorf("GoType() = %v, want map[int32]float64", got)
+	}
+	if got := v.reader.TypeName(); got != "MAP(INTEGER, DOUBLE)" {
+		t.Errorf("TypeName() = %v", got)
+	}
+
+	// Keys that are not comparable in Go are returned as ordered entries
+	lists := testMapVector([]listEntry{{0, 2}}, testListVector([]listEntry{{0, 1}, {1, 1}}, keys), values)
+	want := []MapEntry{{Key: []int32{1}, Value: 0.5}, {Key: []int32{2}, Value: 1.5}}
+	if got := lists.Value(0); !reflect.DeepEqual(got, want) {
+		t.Errorf("Value(0) = %#v, want %#v", got, want)
+	}
+	if got := lists.reader.GoType(); got != reflect.TypeOf([]MapEntry{}) {
+		t.Errorf("GoType() = %v, want []MapEntry", got)
+	}
+}
+
+func TestMapKeyType(t *testing.T) {
+	tests := []struct {
+		value any
+		want  bool
+	}{
+		{"", true},
+		{int32(0), true},
+		{UUID{}, true},
+		{Interval{}, true},
+		{Decimal{}, false},
+		{new(big.Int), false},
+		{[]byte{}, false},
+		{map[string]any{}, false},
+	}
+
+	for _, tt := range tests {
+		if got := mapKeyType(reflect.TypeOf(tt.value)); got != tt.want {
+			t.Errorf("mapKeyType(%T) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestMapScan(t *testing.T) {
+	var m Map[int64, string]
+	if err := m.Scan(map[int32]string{1: "a"}); err != nil || !reflect.DeepEqual(m, Map[int64, string]{1: "a"}) {
+		t.Errorf("Scan(map) = %v, %v", m, err)
+	}
+	if err := m.Scan([]MapEntry{{Key: "2", Value: "b"}}); err != nil || !reflect.DeepEqual(m, Map[int64, string]{2: "b"}) {
+		t.Errorf("Scan([]MapEntry) = %v, %v", m, err)
+	}
+	if err := m.Scan(nil); err != nil || m != nil {
+		t.Errorf("Scan(nil) = %v, %v, want nil", m, err)
+	}
+	if err := m.Scan(map[string]string{"x": "a"}); err == nil {
+		t.Errorf("Scan() with an invalid key succeeded, want error")
+	}
+
+	var optional Map[string, *float64]
+	if err := optional.Scan(map[string]any{"a": nil}); err != nil || optional["a"] != nil {
+		t.Errorf("Scan() = %v, %v, want a nil value", optional, err)
+	}
+
+	// Ordered entries can be read from map_entries() into MapEntry structs
+	var entries List[MapEntry]
+	if err := entries.Scan([]any{map[string]any{"key": int32(1), "value": "a"}}); err != nil || entries[0] != (MapEntry{Key: int32(1), Value: "a"}) {
+		t.Errorf("Scan() into List[MapEntry] = %v, %v", entries, err)
+	}
+}
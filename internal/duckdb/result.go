@@ -2,12 +2,19 @@ package duckdb
 
 import (
 	"time"
+
+	"github.com/fpt/go-pduckdb/internal/convert"
 )
 
 // Result wraps the raw result and provides methods to access the data
 type Result struct {
 	Raw DuckDBResultRaw
 	Db  *DB
+
+	// sql is the statement that produced the result, used for error reporting
+	sql string
+	// stmt is a prepared statement owned by the result, closed together with it
+	stmt *PreparedStatement
 }
 
 // newResult creates a new Result from a database and raw result
@@ -87,14 +94,13 @@ func (r *Result) ValueDate(column int64, row int32) (time.Time, bool) {
 		return time.Time{}, false
 	}
 
-	date := r.Db.ValueDate(&r.Raw, column, row)
-	// DuckDB uses a special value for NULL dates
-	if date == 0 {
-		return time.Time{}, false // NULL value
+	// 1970-01-01 is stored as 0 too, so NULL is detected separately
+	if r.ValueNull(column, row) {
+		return time.Time{}, false
 	}
 
-	// Convert from days since 1970-01-01 to a time.Time
-	return time.Unix(int64(date)*24*60*60, 0).UTC(), true
+	date := r.Db.ValueDate(&r.Raw, column, row)
+	return dateFromDays(date), true
 }
 
 // ValueTime returns the time value at the given column and row
@@ -104,16 +110,13 @@ func (r *Result) ValueTime(column int64, row int32) (time.Time, bool) {
 		return time.Time{}, false
 	}
 
-	timeVal := r.Db.ValueTime(&r.Raw, column, row)
-	// DuckDB typically uses 0 for NULL times
-	if timeVal == 0 {
+	// Midnight is stored as 0 too, so NULL is detected separately
+	if r.ValueNull(column, row) {
 		return time.Time{}, false
 	}
 
-	// Convert from microseconds since 00:00:00 to a time.Time on the current date
-	now := time.Now().UTC()
-	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
-	return midnight.Add(time.Duration(timeVal) * time.Microsecond), true
+	timeVal := r.Db.ValueTime(&r.Raw, column, row)
+	return timeFromMicros(timeVal), true
 }
 
 // ValueTimestamp returns the timestamp (datetime) value at the given column and row
@@ -123,16 +126,13 @@ func (r *Result) ValueTimestamp(column int64, row int32) (time.Time, bool) {
 		return time.Time{}, false
 	}
 
-	timestamp := r.Db.ValueTimestamp(&r.Raw, column, row)
-	// DuckDB typically uses 0 for NULL timestamps
-	if timestamp == 0 {
+	// The epoch is stored as 0 too, so NULL is detected separately
+	if r.ValueNull(column, row) {
 		return time.Time{}, false
 	}
 
-	// Convert from microseconds since epoch to time.Time
-	seconds := timestamp / 1_000_000
-	remainingMicros := timestamp % 1_000_000
-	return time.Unix(seconds, remainingMicros*1000).UTC(), true
+	timestamp := r.Db.ValueTimestamp(&r.Raw, column, row)
+	return timestampFromMicros(timestamp), true
 }
 
 // ValueBoolean returns the boolean value at the given column and row
@@ -340,4 +340,37 @@ func (r *Result) DecimalInfo(column int64) (precision, scale int64, ok bool) {
 // Close destroys the result and frees associated resources
 func (r *Result) Close() {
 	r.Db.DestroyResult(&r.Raw)
+	if r.stmt != nil {
+		_ = r.stmt.Close()
+		r.stmt = nil
+	}
+}
+
+// dateFromDays converts days since 1970-01-01 to a time.Time.
+// Infinite dates are returned as convert.InfinityTime and convert.NegativeInfinityTime.
+func dateFromDays(days int32) time.Time {
+	switch days {
+	case convert.DateInfinity:
+		return convert.InfinityTime
+	case convert.DateNegativeInfinity:
+		return convert.NegativeInfinityTime
+	}
+	return time.Unix(int64(days)*24*60*60, 0).UTC()
+}
+
+// timeFromMicros converts microseconds since 00:00:00 to a time of day on 1970-01-01 UTC,
+// so that equal TIME values always convert to equal time.Time values
+func timeFromMicros(micros int64) time.Time {
+	return convert.Time{Micros: micros}.ToTime()
+}
+
+// timestampFromMicros converts microseconds since epoch to a time.Time.
+// Infinite timestamps are returned as convert.InfinityTime and convert.NegativeInfinityTime.
+func timestampFromMicros(micros int64) time.Time {
+	if t, ok := timestampInfinity(micros); ok {
+		return t
+	}
+	seconds := micros / 1_000_000
+	remainingMicros := micros % 1_000_000
+	return time.Unix(seconds, remainingMicros*1000).UTC()
 }
@@ -0,0 +1,64 @@
+//go:build amd64 && !windows
+
+package duckdb
+
+import (
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+)
+
+// registerResultFuncs registers the functions that take a duckdb_result by value.
+//
+// The System V amd64 ABI passes structs larger than 16 bytes on the stack,
+// which purego only supports on darwin. The six words of duckdb_result are
+// therefore passed as plain stack arguments, after filling the six integer
+// registers with the remaining arguments and padding.
+func registerResultFuncs(db *DB, lib uintptr) {
+	var fetchChunk func(_, _, _, _, _, _, r0, r1, r2, r3, r4, r5 uintptr) DuckDBDataChunk
+	purego.RegisterLibFunc(&fetchChunk, lib, "duckdb_fetch_chunk")
+	db.FetchChunk = func(r *DuckDBResultRaw) DuckDBDataChunk {
+		w := resultWords(r)
+		return fetchChunk(0, 0, 0, 0, 0, 0, w[0], w[1], w[2], w[3], w[4], w[5])
+	}
+
+	var returnType func(_, _, _, _, _, _, r0, r1, r2, r3, r4, r5 uintptr) DuckDBResultType
+	purego.RegisterLibFunc(&returnType, lib, "duckdb_result_return_type")
+	db.ResultReturnType = func(r *DuckDBResultRaw) DuckDBResultType {
+		w := resultWords(r)
+		return returnType(0, 0, 0, 0, 0, 0, w[0], w[1], w[2], w[3], w[4], w[5])
+	}
+
+	var getChunk func(idx int64, _, _, _, _, _, r0, r1, r2, r3, r4, r5 uintptr) DuckDBDataChunk
+	purego.RegisterLibFunc(&getChunk, lib, "duckdb_result_get_chunk")
+	db.ResultGetChunk = func(r *DuckDBResultRaw, idx int64) DuckDBDataChunk {
+		w := resultWords(r)
+		return getChunk(idx, 0, 0, 0, 0, 0, w[0], w[1], w[2], w[3], w[4], w[5])
+	}
+
+	var chunkCount func(_, _, _, _, _, _, r0, r1, r2, r3, r4, r5 uintptr) int64
+	purego.RegisterLibFunc(&chunkCount, lib, "duckdb_result_chunk_count")
+	db.ResultChunkCount = func(r *DuckDBResultRaw) int64 {
+		w := resultWords(r)
+		return chunkCount(0, 0, 0, 0, 0, 0, w[0], w[1], w[2], w[3], w[4], w[5])
+	}
+
+	var isStreaming func(_, _, _, _, _, _, r0, r1, r2, r3, r4, r5 uintptr) bool
+	purego.RegisterLibFunc(&isStreaming, lib, "duckdb_result_is_streaming")
+	db.ResultIsStreaming = func(r *DuckDBResultRaw) bool {
+		w := resultWords(r)
+		return isStreaming(0, 0, 0, 0, 0, 0, w[0], w[1], w[2], w[3], w[4], w[5])
+	}
+
+	var statementType func(_, _, _, _, _, _, r0, r1, r2, r3, r4, r5 uintptr) DuckDBStatementType
+	purego.RegisterLibFunc(&statementType, lib, "duckdb_result_statement_type")
+	db.ResultStatementType = func(r *DuckDBResultRaw) DuckDBStatementType {
+		w := resultWords(r)
+		return statementType(0, 0, 0, 0, 0, 0, w[0], w[1], w[2], w[3], w[4], w[5])
+	}
+}
+
+// resultWords returns the raw words of a result for passing it by value
+func resultWords(r *DuckDBResultRaw) [6]uintptr {
+	return *(*[6]uintptr)(unsafe.Pointer(r))
+}
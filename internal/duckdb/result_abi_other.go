@@ -0,0 +1,20 @@
+//go:build !amd64 || windows
+
+package duckdb
+
+import (
+	"github.com/ebitengine/purego"
+)
+
+// registerResultFuncs registers the functions that take a duckdb_result by value.
+//
+// On arm64 and on Windows, structs larger than 16 bytes are passed as a pointer
+// to a caller-owned copy, so a pointer to the result can be passed directly.
+func registerResultFuncs(db *DB, lib uintptr) {
+	purego.RegisterLibFunc(&db.FetchChunk, lib, "duckdb_fetch_chunk")
+	purego.RegisterLibFunc(&db.ResultReturnType, lib, "duckdb_result_return_type")
+	purego.RegisterLibFunc(&db.ResultGetChunk, lib, "duckdb_result_get_chunk")
+	purego.RegisterLibFunc(&db.ResultChunkCount, lib, "duckdb_result_chunk_count")
+	purego.RegisterLibFunc(&db.ResultIsStreaming, lib, "duckdb_result_is_streaming")
+	purego.RegisterLibFunc(&db.ResultStatementType, lib, "duckdb_result_statement_type")
+}
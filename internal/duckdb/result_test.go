@@ -0,0 +1,34 @@
+package duckdb
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResultTemporalNull(t *testing.T) {
+	// Row 0 holds zero values, row 1 is NULL
+	r := TestResult()
+	r.Db.ValueNull = func(_ *DuckDBResultRaw, _ int64, row int32) bool { return row == 1 }
+
+	epoch := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		value func(row int32) (time.Time, bool)
+	}{
+		{"date", func(row int32) (time.Time, bool) { return r.ValueDate(0, row) }},
+		{"time", func(row int32) (time.Time, bool) { return r.ValueTime(0, row) }},
+		{"timestamp", func(row int32) (time.Time, bool) { return r.ValueTimestamp(0, row) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.value(0)
+			if !ok || !got.Equal(epoch) {
+				t.Errorf("row 0 = %v, %v, want %v, true", got, ok, epoch)
+			}
+			if _, ok := tt.value(1); ok {
+				t.Errorf("row 1 is valid, want NULL")
+			}
+		})
+	}
+}
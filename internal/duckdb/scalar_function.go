@@ -0,0 +1,188 @@
+package duckdb
+
+import (
+	"fmt"
+)
+
+// ScalarFunction describes a scalar function implemented in Go
+type ScalarFunction struct {
+	// Name is the SQL name of the function
+	Name string
+	// Parameters are the types of the fixed parameters
+	Parameters []DuckDBType
+	// Varargs is the type of the variadic arguments following the fixed parameters,
+	// or DuckDBTypeInvalid if the function is not variadic
+	Varargs DuckDBType
+	// Result is the type of the function result
+	Result DuckDBType
+	// Volatile functions are evaluated for every row, even with constant arguments
+	Volatile bool
+	// SpecialHandling passes NULL arguments to Function instead of
+	// folding calls with constant NULL arguments to NULL
+	SpecialHandling bool
+	// Function computes the result of one row.
+	// NULL arguments are passed as nil, and a nil result is stored as NULL.
+	// It is called concurrently from DuckDB's worker threads.
+	Function func(args []any) (any, error)
+
+	// Readers of the parameter, variadic and result types, built once at registration
+	// and shared by all chunks. They are nil for types that depend on the call.
+	params  []*ValueReader
+	varargs *ValueReader
+	result  *ValueReader
+}
+
+// RegisterScalarFunction registers a Go function that can be called from SQL.
+// Functions are registered in the system catalog and are visible to every
+// connection of the database.
+func (c *Connection) RegisterScalarFunction(f *ScalarFunction) error {
+	db := c.db
+	if db.CreateScalarFunction == nil {
+		return fmt.Errorf("scalar functions not available in this DuckDB build")
+	}
+	if f.Function == nil {
+		return fmt.Errorf("scalar function %s has no implementation", f.Name)
+	}
+	initCallbacks()
+
+	sf := db.CreateScalarFunction()
+	defer db.DestroyScalarFunction(&sf)
+
+	cName := ToCString(f.Name)
+	defer FreeCString(cName)
+	db.ScalarFunctionSetName(sf, cName)
+
+	for _, t := range f.Parameters {
+		db.withLogicalType(t, func(lt DuckDBLogicalType) {
+			db.ScalarFunctionAddParameter(sf, lt)
+		})
+	}
+	if f.Varargs != DuckDBTypeInvalid {
+		db.withLogicalType(f.Varargs, func(lt DuckDBLogicalType) {
+			db.ScalarFunctionSetVarargs(sf, lt)
+		})
+	}
+	db.withLogicalType(f.Result, func(lt DuckDBLogicalType) {
+		db.ScalarFunctionSetReturnType(sf, lt)
+	})
+	if f.Volatile {
+		db.ScalarFunctionSetVolatile(sf)
+	}
+	if f.SpecialHandling {
+		db.ScalarFunctionSetSpecialHandling(sf)
+	}
+
+	f.params = make([]*ValueReader, len(f.Parameters))
+	for i, t := range f.Parameters {
+		f.params[i] = db.fixedReader(t)
+	}
+	f.varargs = db.fixedReader(f.Varargs)
+	f.result = db.fixedReader(f.Result)
+
+	// DuckDB owns the registry entry from here on and
+	// removes it through the delete callback, which closes the readers
+	id := register(db, f)
+	db.ScalarFunctionSetExtraInfo(sf, id, callbacks.deleteData)
+	db.ScalarFunctionSetFunction(sf, callbacks.scalar)
+
+	if state := db.RegisterScalarFunction(c.handle, sf); state != DuckDBSuccess {
+		return fmt.Errorf("failed to register scalar function %s", f.Name)
+	}
+
+	return nil
+}
+
+// fixedReader returns a reader for values of type t, or nil when the logical type of
+// the values depends on the call, like the width of a DECIMAL or the type of ANY
+func (db *DB) fixedReader(t DuckDBType) *ValueReader {
+	switch t {
+	case DuckDBTypeInvalid, DuckDBTypeAny, DuckDBTypeDecimal, DuckDBTypeEnum,
+		DuckDBTypeList, DuckDBTypeStruct, DuckDBTypeMap, DuckDBTypeArray, DuckDBTypeUnion:
+		return nil
+	}
+	return NewValueReader(db, db.CreateLogicalType(t))
+}
+
+// argReader returns the reader of the argument at index i, or nil if its type depends on the call
+func (f *ScalarFunction) argReader(i int) *ValueReader {
+	if i < len(f.params) {
+		return f.params[i]
+	}
+	return f.varargs
+}
+
+// close closes the readers once DuckDB drops the function
+func (f *ScalarFunction) close() {
+	readers := append([]*ValueReader{f.varargs, f.result}, f.params...)
+	for _, reader := range readers {
+		if reader != nil {
+			reader.Close()
+		}
+	}
+	f.params, f.varargs, f.result = nil, nil, nil
+}
+
+// withLogicalType calls fn with a logical type for the type id and destroys it afterwards
+func (db *DB) withLogicalType(t DuckDBType, fn func(DuckDBLogicalType)) {
+	lt := db.CreateLogicalType(t)
+	defer db.DestroyLogicalType(&lt)
+	fn(lt)
+}
+
+// scalarFunctionCallback is called by DuckDB to compute a chunk of results
+func scalarFunctionCallback(info DuckDBFunctionInfo, input DuckDBDataChunk, output DuckDBVector) {
+	db := callbackDB()
+	f, ok := lookup(db.ScalarFunctionGetExtraInfo(info)).(*ScalarFunction)
+	if !ok {
+		setCallbackError(db.ScalarFunctionSetError, info, "scalar function is not registered")
+		return
+	}
+
+	if err := f.execute(db, input, output); err != nil {
+		setCallbackError(db.ScalarFunctionSetError, info, err.Error())
+	}
+}
+
+// execute computes the results for all rows of the input chunk
+func (f *ScalarFunction) execute(db *DB, input DuckDBDataChunk, output DuckDBVector) (err error) {
+	defer recoverError(f.Name, &err)
+
+	columnCount := int(db.DataChunkGetColumnCount(input))
+	size := int(db.DataChunkGetSize(input))
+
+	args := make([]*Vector, columnCount)
+	for i := range args {
+		handle := db.DataChunkGetVector(input, int64(i))
+		reader := f.argReader(i)
+		if reader == nil {
+			// The logical type depends on the call, like the width of a DECIMAL
+			reader = NewValueReader(db, db.VectorGetLogicalColumnType(handle))
+			defer reader.Close()
+		}
+		args[i] = reader.Vector(handle)
+	}
+
+	resultReader := f.result
+	if resultReader == nil {
+		resultReader = NewValueReader(db, db.VectorGetLogicalColumnType(output))
+		defer resultReader.Close()
+	}
+	result := resultReader.Vector(output)
+
+	values := make([]any, columnCount)
+	for row := 0; row < size; row++ {
+		for i, arg := range args {
+			values[i] = arg.Value(row)
+		}
+
+		value, err := f.Function(values)
+		if err != nil {
+			return err
+		}
+		if err := result.SetValue(row, value); err != nil {
+			return fmt.Errorf("invalid result of function %s: %w", f.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package duckdb
+
+import "testing"
+
+func TestScalarFunctionArgReader(t *testing.T) {
+	first := &ValueReader{typeID: DuckDBTypeBigint}
+	rest := &ValueReader{typeID: DuckDBTypeVarchar}
+	f := &ScalarFunction{params: []*ValueReader{first, nil}, varargs: rest}
+
+	// Fixed parameters have their own reader, or none when the type depends on the call
+	if got := f.argReader(0); got != first {
+		t.Errorf("argReader(0) = %v, want the reader of the first parameter", got)
+	}
+	if got := f.argReader(1); got != nil {
+		t.Errorf("argReader(1) = %v, want nil", got)
+	}
+	// Variadic arguments share the reader of the variadic type
+	for _, i := range []int{2, 5} {
+		if got := f.argReader(i); got != rest {
+			t.Errorf("argReader(%d) = %v, want the variadic reader", i, got)
+		}
+	}
+
+	f.close()
+	if f.params != nil || f.varargs != nil || f.result != nil {
+		t.Errorf("close() kept readers")
+	}
+}
@@ -4,6 +4,7 @@ package duckdb
 import (
 	"fmt"
 	"math"
+	"unsafe"
 
 	"github.com/fpt/go-pduckdb/internal/convert"
 	"github.com/pkg/errors"
@@ -13,6 +14,7 @@ import (
 type PreparedStatement struct {
 	handle    DuckDBPreparedStatement
 	conn      *Connection
+	query     string
 	numParams int32
 }
 
@@ -31,14 +33,37 @@ func (ps *PreparedStatement) ParameterName(paramIdx int) (string, error) {
 		return "", fmt.Errorf("parameter name function not available")
 	}
 
-	// Parameter indices in DuckDB are 0-based for parameter_name
-	idx := int64(paramIdx - 1)
-	namePtr := ps.conn.db.ParameterName(ps.handle, idx)
+	// Parameter indices in DuckDB are 1-based for parameter_name.
+	// Positional parameters are named by their index, like "1" for ? or $1.
+	namePtr := ps.conn.db.ParameterName(ps.handle, int64(paramIdx))
 	if namePtr == nil {
 		return "", nil // No name for this parameter
 	}
 
-	return GoString(namePtr), nil
+	return ps.conn.db.takeString(namePtr), nil
+}
+
+// ParameterIndex returns the 1-based index of the parameter with the given name,
+// like "tenant" for $tenant. Names are matched case-insensitively.
+func (ps *PreparedStatement) ParameterIndex(name string) (int, error) {
+	if ps.handle == nil {
+		return 0, fmt.Errorf("prepared statement is closed")
+	}
+
+	if ps.conn.db.BindParameterIndex == nil {
+		return 0, fmt.Errorf("bind parameter index function not available")
+	}
+
+	cName := ToCString(name)
+	defer FreeCString(cName)
+
+	var idx uint64
+	state := ps.conn.db.BindParameterIndex(ps.handle, &idx, cName)
+	if state != DuckDBSuccess {
+		return 0, fmt.Errorf("unknown parameter $%s", name)
+	}
+
+	return int(idx), nil
 }
 
 // ParameterType returns the DuckDB type of the parameter at the given index
@@ -130,7 +155,13 @@ func (ps *PreparedStatement) BindParameter(paramIdx int, value any) error {
 
 	// Use DuckDB parameter type to guide binding if available
 	if logicalType == nil {
-		return fmt.Errorf("parameter type is invalid")
+		if !IsNestedValue(value) {
+			return fmt.Errorf("parameter type is invalid")
+		}
+		if err := bindValue(ps.conn.db, ps.handle, paramIdx, value, nil); err != nil {
+			return fmt.Errorf("failed to bind parameter: %w", err)
+		}
+		return nil
 	}
 
 	err := bindParameter(ps.conn.db, ps.handle, paramIdx, value, logicalType)
@@ -154,15 +185,46 @@ func (ps *PreparedStatement) Execute() (*Result, error) {
 	var rawResult DuckDBResultRaw
 	state := ps.conn.db.ExecutePrepared(ps.handle, &rawResult)
 	if state != DuckDBSuccess {
-		// Get error message if possible
-		if ps.conn.db.ResultError != nil {
-			errMsg := GoString(ps.conn.db.ResultError(&rawResult))
-			return nil, fmt.Errorf("failed to execute prepared statement: %s", errMsg)
-		}
-		return nil, fmt.Errorf("failed to execute prepared statement")
+		// The result carries the error and must be destroyed even on failure
+		err := ps.conn.db.resultError(&rawResult, ps.query)
+		ps.conn.db.DestroyResult(&rawResult)
+		return nil, err
 	}
 
 	internalResult := newResult(ps.conn.db, rawResult)
+	internalResult.sql = ps.query
+	ps.conn.executed(internalResult)
+
+	return internalResult, nil
+}
+
+// ExecuteStreaming executes a prepared statement with bound parameters and
+// returns a streaming result, whose rows are computed as chunks are fetched.
+// The statement must not be closed before the result.
+func (ps *PreparedStatement) ExecuteStreaming() (*Result, error) {
+	if ps.handle == nil {
+		return nil, fmt.Errorf("prepared statement is closed")
+	}
+
+	if ps.conn.db.ExecutePreparedStreaming == nil {
+		return nil, fmt.Errorf("execute prepared streaming function not available")
+	}
+
+	// TIMESTAMP WITH TIME ZONE values of the result are returned in the current TimeZone
+	ps.conn.refreshTimeZone()
+
+	var rawResult DuckDBResultRaw
+	state := ps.conn.db.ExecutePreparedStreaming(ps.handle, &rawResult)
+	if state != DuckDBSuccess {
+		// The result carries the error and must be destroyed even on failure
+		err := ps.conn.db.resultError(&rawResult, ps.query)
+		ps.conn.db.DestroyResult(&rawResult)
+		return nil, err
+	}
+
+	internalResult := newResult(ps.conn.db, rawResult)
+	internalResult.sql = ps.query
+	ps.conn.executed(internalResult)
 
 	return internalResult, nil
 }
@@ -362,8 +424,16 @@ func bindParameter(
 		}
 
 	case DuckDBTypeBlob:
-		// DuckDBTypeBlob is not supported.
-		return fmt.Errorf("blob type is not supported")
+		blobVal, err := toBlob(value)
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert value to BLOB")
+		}
+		if db.BindBlob != nil {
+			// DuckDB copies the blob, which may contain zero bytes
+			state = db.BindBlob(ps, idx, unsafe.Pointer(dataPointer(unsafe.SliceData(blobVal))), int64(len(blobVal)))
+		} else {
+			return fmt.Errorf("no suitable bind function available for BLOB")
+		}
 
 	case DuckDBTypeDate:
 		// Convert to Date
@@ -374,7 +444,7 @@ func bindParameter(
 		if db.BindDate != nil {
 			state = db.BindDate(ps, idx, int32(dateVal.Days))
 		} else if db.BindVarchar != nil {
-			dateStr := dateVal.ToTime().Format("2006-01-02")
+			dateStr := formatTimestamp(dateVal.ToTime(), "2006-01-02")
 			cStr := ToCString(dateStr)
 			defer FreeCString(cStr)
 			state = db.BindVarchar(ps, idx, cStr)
@@ -407,11 +477,11 @@ func bindParameter(
 		}
 		if db.BindTimestamp != nil {
 			// Convert to DuckDB timestamp (microseconds since epoch)
-			micros := timestampVal.UnixNano() / 1000
+			micros := timestampUnits(timestampVal, DuckDBTypeTimestamp)
 			state = db.BindTimestamp(ps, idx, micros)
 		} else if db.BindVarchar != nil {
 			// Fall back to string representation
-			timestampStr := timestampVal.Format("2006-01-02 15:04:05.999999")
+			timestampStr := formatTimestamp(timestampVal, "2006-01-02 15:04:05.999999")
 			cStr := ToCString(timestampStr)
 			defer FreeCString(cStr)
 			state = db.BindVarchar(ps, idx, cStr)
@@ -419,39 +489,167 @@ func bindParameter(
 			return fmt.Errorf("no suitable bind function available for TIMESTAMP")
 		}
 
+	case DuckDBTypeTimestampS, DuckDBTypeTimestampMS, DuckDBTypeTimestampNS, DuckDBTypeTimestampTZ, DuckDBTypeTimeTZ:
+		// Bound as text, which DuckDB casts without losing precision or the UTC offset
+		text, err := temporalText(value, paramType)
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert value to %s", paramType)
+		}
+		if db.BindVarchar != nil {
+			cStr := ToCString(text)
+			defer FreeCString(cStr)
+			state = db.BindVarchar(ps, idx, cStr)
+		} else {
+			return fmt.Errorf("no suitable bind function available for %s", paramType)
+		}
+
 	case DuckDBTypeInterval:
-		// DuckDBTypeInterval is not supported due to purego limitations
-		return fmt.Errorf("interval type is not supported")
+		intervalVal, err := toInterval(value)
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert value to INTERVAL")
+		}
+		if db.BindInterval != nil {
+			state = db.BindInterval(ps, idx, &intervalVal)
+		} else if db.BindVarchar != nil {
+			cStr := ToCString(intervalVal.String())
+			defer FreeCString(cStr)
+			state = db.BindVarchar(ps, idx, cStr)
+		} else {
+			return fmt.Errorf("no suitable bind function available for INTERVAL")
+		}
+
+	case DuckDBTypeHugeint, DuckDBTypeUHugeint:
+		n, err := toBigInt(value)
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert value to %s", paramType)
+		}
+		h, err := toHugeint(n, paramType == DuckDBTypeUHugeint)
+		if err != nil {
+			return err
+		}
+		if paramType == DuckDBTypeHugeint && db.BindHugeint != nil {
+			state = db.BindHugeint(ps, idx, &h)
+		} else if paramType == DuckDBTypeUHugeint && db.BindUHugeint != nil {
+			state = db.BindUHugeint(ps, idx, &h)
+		} else if db.BindVarchar != nil {
+			cStr := ToCString(n.String())
+			defer FreeCString(cStr)
+			state = db.BindVarchar(ps, idx, cStr)
+		} else {
+			return fmt.Errorf("no suitable bind function available for %s", paramType)
+		}
+
+	case DuckDBTypeVarInt:
+		// There is no bind function for VARINT, DuckDB casts the text representation exactly
+		n, err := toBigInt(value)
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert value to VARINT")
+		}
+		if db.BindVarchar != nil {
+			cStr := ToCString(n.String())
+			defer FreeCString(cStr)
+			state = db.BindVarchar(ps, idx, cStr)
+		} else {
+			return fmt.Errorf("no suitable bind function available for VARINT")
+		}
+
+	case DuckDBTypeBit:
+		// There is no bind function for BIT, DuckDB casts the text representation
+		bitVal, err := toBitString(value)
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert value to BIT")
+		}
+		if db.BindVarchar != nil {
+			cStr := ToCString(bitVal.String())
+			defer FreeCString(cStr)
+			state = db.BindVarchar(ps, idx, cStr)
+		} else {
+			return fmt.Errorf("no suitable bind function available for BIT")
+		}
+
+	case DuckDBTypeEnum:
+		// ENUM values are bound as text, after checking them against the dictionary
+		strVal, err := convert.ToString(value)
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert value to ENUM")
+		}
+		if _, err := enumValueIndex(enumDictionary(db, logicalType), strVal); err != nil {
+			return err
+		}
+		if db.BindVarchar != nil {
+			cStr := ToCString(strVal)
+			defer FreeCString(cStr)
+			state = db.BindVarchar(ps, idx, cStr)
+		} else {
+			return fmt.Errorf("no suitable bind function available for ENUM")
+		}
+
+	case DuckDBTypeUUID:
+		// There is no bind function for UUID, DuckDB casts the text representation
+		uuidVal, err := toUUID(value)
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert value to UUID")
+		}
+		if db.BindVarchar != nil {
+			cStr := ToCString(uuidVal.String())
+			defer FreeCString(cStr)
+			state = db.BindVarchar(ps, idx, cStr)
+		} else {
+			return fmt.Errorf("no suitable bind function available for UUID")
+		}
 
-	// For types where we have limited support, fall back to string representation
 	case DuckDBTypeDecimal:
-		// Convert to double - DuckDB uses double internally for DECIMAL
-		doubleVal, err := convert.ToFloat64(value)
+		// Decimals are bound exactly, never through float64
+		decimalVal, err := toDecimal(value)
 		if err != nil {
 			return errors.Wrapf(err, "failed to convert value to DECIMAL")
 		}
-
-		if db.BindDouble != nil {
-			state = db.BindDouble(ps, idx, doubleVal)
+		if db.BindDecimal != nil {
+			d := decimalVal.toC()
+			state = db.BindDecimal(ps, idx, &d)
 		} else if db.BindVarchar != nil {
-			// If bind_double is not available, fall back to string representation
-			// Format with high precision to preserve decimal places
-			decimalStr := fmt.Sprintf("%.15g", doubleVal)
-			cStr := ToCString(decimalStr)
+			// DuckDB casts the text representation exactly
+			cStr := ToCString(decimalVal.String())
 			defer FreeCString(cStr)
 			state = db.BindVarchar(ps, idx, cStr)
 		} else {
 			return fmt.Errorf("no suitable bind function available for DECIMAL")
 		}
 
-	case DuckDBTypeMap:
-		return fmt.Errorf("map type is not supported")
+	case DuckDBTypeArray:
+		// Slices and Go arrays are bound as typed values, checked against the size of the ARRAY
+		return bindValue(db, ps, paramIdx, value, logicalType)
 
-	case DuckDBTypeList:
-		return fmt.Errorf("list type is not supported")
+	case DuckDBTypeUnion:
+		// The member value is bound with the member's type,
+		// and DuckDB casts it to the UNION member of that type
+		unionVal, err := toUnion(value)
+		if err != nil {
+			return err
+		}
+		memberType, err := unionMemberType(db, logicalType, unionVal.Tag)
+		if err != nil {
+			return err
+		}
+		defer db.DestroyLogicalType(&memberType)
+		if unionVal.Value == nil {
+			state = db.BindNull(ps, idx)
+			break
+		}
+		if err := bindParameter(db, ps, paramIdx, unionVal.Value, memberType); err != nil {
+			return errors.Wrapf(err, "failed to bind UNION member %s", unionVal.Tag)
+		}
+		return nil
 
-	case DuckDBTypeStruct:
-		return fmt.Errorf("struct type is not supported")
+	case DuckDBTypeList, DuckDBTypeMap, DuckDBTypeStruct:
+		return bindValue(db, ps, paramIdx, value, logicalType)
+
+	case DuckDBTypeInvalid, DuckDBTypeAny:
+		// Nested values carry their own type, which DuckDB uses for parameters it cannot infer
+		if IsNestedValue(value) {
+			return bindValue(db, ps, paramIdx, value, nil)
+		}
+		return fmt.Errorf("unsupported parameter type: %s", paramType)
 
 	default:
 		return fmt.Errorf("unsupported parameter type: %s", paramType)
@@ -463,3 +661,22 @@ func bindParameter(
 
 	return nil
 }
+
+// bindValue binds a LIST, ARRAY, MAP or STRUCT parameter to a duckdb_value built from
+// a Go slice, map or struct, which DuckDB casts to the parameter type.
+// Without a logical type, the value has the type of the Go value.
+func bindValue(db *DB, ps DuckDBPreparedStatement, paramIdx int, value any, logicalType DuckDBLogicalType) error {
+	if db.BindValue == nil {
+		return fmt.Errorf("no suitable bind function available for nested values")
+	}
+	nested, err := db.createValue(value, logicalType)
+	if err != nil {
+		return errors.Wrapf(err, "failed to convert %T to a nested value", value)
+	}
+	defer db.DestroyValue(&nested)
+
+	if state := db.BindValue(ps, int32(paramIdx), nested); state != DuckDBSuccess {
+		return fmt.Errorf("failed to bind nested value of type %T", value)
+	}
+	return nil
+}
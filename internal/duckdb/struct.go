@@ -0,0 +1,191 @@
+package duckdb
+
+import (
+	"database/sql"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ScanStruct returns a sql.Scanner that fills the struct dest points to from a STRUCT value.
+// STRUCT children are matched to fields by their `duckdb:"name"` tag, or by the field name,
+// ignoring case. Fields tagged `duckdb:"-"` and children without a field are skipped.
+// A NULL child leaves its field at the zero value; pointer fields tell NULL apart.
+// If dest points to a pointer, a NULL STRUCT sets it to nil, otherwise NULL is an error.
+func ScanStruct(dest any) sql.Scanner {
+	return &structScanner{dest: dest}
+}
+
+// structScanner implements ScanStruct
+type structScanner struct {
+	dest any
+}
+
+// Scan implements sql.Scanner
+func (s *structScanner) Scan(src any) error {
+	dest := reflect.ValueOf(s.dest)
+	if dest.Kind() != reflect.Pointer || dest.IsNil() {
+		return errors.Errorf("ScanStruct needs a non-nil pointer, got %T", s.dest)
+	}
+
+	out, err := assign(src, dest.Elem().Type())
+	if err != nil {
+		return err
+	}
+	dest.Elem().Set(out)
+	return nil
+}
+
+// structValue decodes the STRUCT at the given row into a map of its children by name
+func (v *Vector) structValue(row int) map[string]any {
+	values := make(map[string]any, len(v.children))
+	for i, child := range v.children {
+		values[v.reader.childNames[i]] = child.Value(row)
+	}
+	return values
+}
+
+// assignStruct converts the children of a STRUCT to a struct of the target type
+func assignStruct(children map[string]any, target reflect.Type) (reflect.Value, error) {
+	out := reflect.New(target).Elem()
+	for name, value := range children {
+		index, ok := structField(target, name)
+		if !ok || value == nil {
+			continue
+		}
+		field := out.Field(index)
+		converted, err := assign(value, field.Type())
+		if err != nil {
+			return reflect.Value{}, errors.Wrapf(err, "field %s", name)
+		}
+		field.Set(converted)
+	}
+	return out, nil
+}
+
+// structField returns the index of the exported field of t that receives the STRUCT child name
+func structField(t reflect.Type, name string) (int, bool) {
+	fallback := -1
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag, hasTag := field.Tag.Lookup("duckdb")
+		if tag == "-" {
+			continue
+		}
+		if hasTag && tag != "" {
+			if tag == name {
+				return i, true
+			}
+			continue
+		}
+		if field.Name == name {
+			return i, true
+		}
+		if fallback < 0 && strings.EqualFold(field.Name, name) {
+			fallback = i
+		}
+	}
+	return fallback, fallback >= 0
+}
+
+// createStructValue creates a STRUCT value from a struct, matching fields to STRUCT children
+// like ScanStruct, or from a map keyed by child name. Children without a field or key are NULL.
+// The STRUCT has the types of its children and is cast by DuckDB to the parameter type.
+// Without a STRUCT type, the children are the exported fields or the map keys.
+func (db *DB) createStructValue(v reflect.Value, structType DuckDBLogicalType) (DuckDBValue, error) {
+	if v.Kind() != reflect.Struct && (v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String) {
+		return nil, errors.Errorf("cannot convert %s to STRUCT, expected a struct or a map with string keys", v.Type())
+	}
+
+	var names []string
+	var childTypes []DuckDBLogicalType
+	if structType != nil {
+		count := db.StructTypeChildCount(structType)
+		for i := int64(0); i < count; i++ {
+			names = append(names, db.takeString(db.StructTypeChildName(structType, i)))
+			childTypes = append(childTypes, db.StructTypeChildType(structType, i))
+		}
+		defer func() {
+			for i := range childTypes {
+				db.DestroyLogicalType(&childTypes[i])
+			}
+		}()
+	} else {
+		names = structChildNames(v)
+		childTypes = make([]DuckDBLogicalType, len(names))
+	}
+	if len(names) == 0 {
+		return nil, errors.Errorf("cannot convert %s to a STRUCT without children", v.Type())
+	}
+
+	values := make([]DuckDBValue, len(names))
+	defer db.destroyValues(values)
+	types := make([]DuckDBLogicalType, len(names))
+	cNames := make([]*byte, len(names))
+	for i, name := range names {
+		value, err := db.createValue(structChild(v, name), childTypes[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "field %s", name)
+		}
+		values[i] = value
+		types[i] = db.valueType(value, childTypes[i])
+		cNames[i] = ToCString(name)
+	}
+
+	valueType := db.CreateStructType(&types[0], &cNames[0], int64(len(names)))
+	defer db.DestroyLogicalType(&valueType)
+	runtime.KeepAlive(cNames)
+	value := db.CreateStructValue(valueType, &values[0])
+	if value == nil {
+		return nil, errors.New("failed to create STRUCT value")
+	}
+	return value, nil
+}
+
+// structChild returns the value of the STRUCT child name in a struct or map, or nil if there is none
+func structChild(v reflect.Value, name string) any {
+	if v.Kind() == reflect.Map {
+		child := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+		if !child.IsValid() {
+			return nil
+		}
+		return child.Interface()
+	}
+	index, ok := structField(v.Type(), name)
+	if !ok {
+		return nil
+	}
+	return v.Field(index).Interface()
+}
+
+// structChildNames returns the STRUCT child names of a struct's exported fields,
+// or the sorted keys of a map
+func structChildNames(v reflect.Value) []string {
+	var names []string
+	if v.Kind() == reflect.Map {
+		for _, key := range v.MapKeys() {
+			names = append(names, key.String())
+		}
+		sort.Strings(names)
+		return names
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		tag, hasTag := field.Tag.Lookup("duckdb")
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+		if hasTag && tag != "" {
+			names = append(names, tag)
+		} else {
+			names = append(names, field.Name)
+		}
+	}
+	return names
+}
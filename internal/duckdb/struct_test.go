@@ -0,0 +1,94 @@
+package duckdb
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testStructVector creates a STRUCT vector over named child vectors
+func testStructVector(names []string, children ...*Vector) *Vector {
+	v := &Vector{reader: &ValueReader{typeID: DuckDBTypeStruct, childNames: names}, children: children}
+	for _, child := range children {
+		v.reader.children = append(v.reader.children, child.reader)
+	}
+	return v
+}
+
+func TestVectorStruct(t *testing.T) {
+	ids := testVector(DuckDBTypeInteger, []int32{7, 8}, []uint64{0b01})
+	scores := testListVector([]listEntry{{0, 2}, {2, 0}}, testVector(DuckDBTypeDouble, []float64{1.5, 2.5}, nil))
+	v := testStructVector([]string{"id", "scores"}, ids, scores)
+
+	want := map[string]any{"id": int32(7), "scores": []float64{1.5, 2.5}}
+	if got := v.Value(0); !reflect.DeepEqual(got, want) {
+		t.Errorf("Value(0) = %#v, want %#v", got, want)
+	}
+	// NULL children are nil
+	want = map[string]any{"id": nil, "scores": []float64{}}
+	if got := v.Value(1); !reflect.DeepEqual(got, want) {
+		t.Errorf("Value(1) = %#v, want %#v", got, want)
+	}
+	if got := v.text(0); got != "{'id': 7, 'scores': [1.5, 2.5]}" {
+		t.Errorf("text(0) = %v", got)
+	}
+	if got := v.reader.TypeName(); got != "STRUCT(id INTEGER, scores DOUBLE[])" {
+		t.Errorf("TypeName() = %v", got)
+	}
+}
+
+func TestScanStruct(t *testing.T) {
+	type device struct {
+		Model string `duckdb:"model_name"`
+		OS    *string
+	}
+	type event struct {
+		ID      int64
+		Kind    string `duckdb:"type"`
+		Tags    []string
+		Device  device
+		Parent  *device
+		Ignored string `duckdb:"-"`
+		secret  string
+	}
+
+	src := map[string]any{
+		"id":      int32(1),
+		"type":    "click",
+		"tags":    []string{"a", "b"},
+		"device":  map[string]any{"model_name": "pixel", "os": nil},
+		"parent":  nil,
+		"Ignored": "x",
+		"secret":  "y",
+		"extra":   true,
+	}
+
+	var e event
+	if err := ScanStruct(&e).Scan(src); err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	want := event{ID: 1, Kind: "click", Tags: []string{"a", "b"}, Device: device{Model: "pixel"}}
+	if !reflect.DeepEqual(e, want) {
+		t.Errorf("Scan() = %+v, want %+v", e, want)
+	}
+
+	// A NULL STRUCT sets a pointer destination to nil
+	p := &event{}
+	if err := ScanStruct(&p).Scan(nil); err != nil || p != nil {
+		t.Errorf("Scan(nil) = %v, %v, want nil", p, err)
+	}
+	if err := ScanStruct(&e).Scan(nil); err == nil {
+		t.Errorf("Scan(nil) into a struct succeeded, want error")
+	}
+	if err := ScanStruct(&e).Scan(map[string]any{"id": "not a number"}); err == nil {
+		t.Errorf("Scan() with an invalid field succeeded, want error")
+	}
+	if err := ScanStruct(e).Scan(src); err == nil {
+		t.Errorf("Scan() into a non-pointer succeeded, want error")
+	}
+
+	// Lists of structs fill slices of structs
+	var devices List[device]
+	if err := devices.Scan([]any{map[string]any{"model_name": "a"}, map[string]any{"model_name": "b"}}); err != nil || len(devices) != 2 || devices[1].Model != "b" {
+		t.Errorf("Scan() into List[device] = %v, %v", devices, err)
+	}
+}
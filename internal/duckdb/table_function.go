@@ -0,0 +1,320 @@
+package duckdb
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// TableFunction describes a table function implemented in Go
+type TableFunction struct {
+	// Name is the SQL name of the function
+	Name string
+	// Parameters are the types of the positional parameters
+	Parameters []DuckDBType
+	// NamedParameters are the types of the named parameters, passed as name := value
+	NamedParameters map[string]DuckDBType
+	// ProjectionPushdown lets the function produce only the columns used by the query
+	ProjectionPushdown bool
+	// Bind is called when a query calling the function is planned.
+	// It receives the positional arguments and the named arguments that were given,
+	// and describes the table that the call produces.
+	Bind func(args []any, named map[string]any) (*TableSource, error)
+}
+
+// TableColumn is a column of the table produced by a table function
+type TableColumn struct {
+	Name string
+	Type DuckDBType
+}
+
+// TableSource describes the table produced by one table function call
+type TableSource struct {
+	// Columns are the columns of the table
+	Columns []TableColumn
+	// Cardinality is the estimated number of rows, or 0 if unknown.
+	// It helps the planner order joins.
+	Cardinality int
+	// ExactCardinality reports whether Cardinality is the exact number of rows
+	ExactCardinality bool
+	// Scan starts producing the rows of the table. columns holds the indexes of
+	// the columns to produce, in output order; without projection pushdown these
+	// are all columns. A prepared statement scans the same source once per execution.
+	Scan func(columns []int) (TableRows, error)
+}
+
+// TableRows produces the rows of a table function scan.
+// If it implements io.Closer, it is closed when the scan ends.
+type TableRows interface {
+	// Next stores the values of the next row in row, which has one entry
+	// per scanned column. It returns false once all rows are produced.
+	Next(row []any) (bool, error)
+}
+
+// tableScan is the state of one scan of a table function
+type tableScan struct {
+	rows TableRows
+	// columns are the indexes of the scanned columns, or -1 for
+	// columns DuckDB asks for that the source does not have
+	columns []int
+	row     []any
+	readers []*ValueReader
+	// done is set once rows are exhausted, so Next is not called again
+	done bool
+}
+
+// close releases the scan's rows and readers
+func (s *tableScan) close() {
+	for _, reader := range s.readers {
+		reader.Close()
+	}
+	if c, ok := s.rows.(io.Closer); ok {
+		_ = c.Close()
+	}
+}
+
+// RegisterTableFunction registers a Go table function that can be queried from SQL.
+// Functions are registered in the system catalog and are visible to every
+// connection of the database.
+func (c *Connection) RegisterTableFunction(f *TableFunction) error {
+	db := c.db
+	if db.CreateTableFunction == nil {
+		return fmt.Errorf("table functions not available in this DuckDB build")
+	}
+	if f.Bind == nil {
+		return fmt.Errorf("table function %s has no bind function", f.Name)
+	}
+	initCallbacks()
+
+	tf := db.CreateTableFunction()
+	defer db.DestroyTableFunction(&tf)
+
+	cName := ToCString(f.Name)
+	defer FreeCString(cName)
+	db.TableFunctionSetName(tf, cName)
+
+	for _, t := range f.Parameters {
+		db.withLogicalType(t, func(lt DuckDBLogicalType) {
+			db.TableFunctionAddParameter(tf, lt)
+		})
+	}
+	for _, name := range sortedNames(f.NamedParameters) {
+		cParam := ToCString(name)
+		db.withLogicalType(f.NamedParameters[name], func(lt DuckDBLogicalType) {
+			db.TableFunctionAddNamedParameter(tf, cParam, lt)
+		})
+		FreeCString(cParam)
+	}
+	db.TableFunctionSupportsProjectionPushdown(tf, f.ProjectionPushdown)
+
+	// DuckDB owns the registry entry from here on and
+	// removes it through the delete callback
+	id := register(db, f)
+	db.TableFunctionSetExtraInfo(tf, id, callbacks.deleteData)
+	db.TableFunctionSetBind(tf, callbacks.tableBind)
+	db.TableFunctionSetInit(tf, callbacks.tableInit)
+	db.TableFunctionSetFunction(tf, callbacks.tableFunction)
+
+	if state := db.RegisterTableFunction(c.handle, tf); state != DuckDBSuccess {
+		return fmt.Errorf("failed to register table function %s", f.Name)
+	}
+
+	return nil
+}
+
+// sortedNames returns the names of the named parameters in a stable order
+func sortedNames(params map[string]DuckDBType) []string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// tableBindCallback is called by DuckDB to plan a call of a table function
+func tableBindCallback(info DuckDBBindInfo) {
+	db := callbackDB()
+	f, ok := lookup(db.BindGetExtraInfo(info)).(*TableFunction)
+	if !ok {
+		setCallbackError(db.BindSetError, info, "table function is not registered")
+		return
+	}
+
+	if err := f.bind(db, info); err != nil {
+		setCallbackError(db.BindSetError, info, err.Error())
+	}
+}
+
+// bind passes the arguments of a call to Bind and declares the resulting table
+func (f *TableFunction) bind(db *DB, info DuckDBBindInfo) (err error) {
+	defer recoverError(f.Name, &err)
+
+	args := make([]any, db.BindGetParameterCount(info))
+	for i := range args {
+		v := db.BindGetParameter(info, int64(i))
+		args[i] = db.goValue(v)
+		db.DestroyValue(&v)
+	}
+
+	named := make(map[string]any)
+	for name := range f.NamedParameters {
+		cName := ToCString(name)
+		v := db.BindGetNamedParameter(info, cName)
+		FreeCString(cName)
+		if v == nil {
+			// Not given in the call
+			continue
+		}
+		named[name] = db.goValue(v)
+		db.DestroyValue(&v)
+	}
+
+	source, err := f.Bind(args, named)
+	if err != nil {
+		return err
+	}
+	if source == nil || len(source.Columns) == 0 {
+		return fmt.Errorf("table function %s must produce at least one column", f.Name)
+	}
+	if source.Scan == nil {
+		return fmt.Errorf("table function %s has no scan function", f.Name)
+	}
+
+	for _, column := range source.Columns {
+		cName := ToCString(column.Name)
+		db.withLogicalType(column.Type, func(lt DuckDBLogicalType) {
+			db.BindAddResultColumn(info, cName, lt)
+		})
+		FreeCString(cName)
+	}
+	if source.Cardinality > 0 {
+		db.BindSetCardinality(info, int64(source.Cardinality), source.ExactCardinality)
+	}
+
+	db.BindSetBindData(info, register(db, source), callbacks.deleteData)
+	return nil
+}
+
+// tableInitCallback is called by DuckDB to start a scan of a table function
+func tableInitCallback(info DuckDBInitInfo) {
+	db := callbackDB()
+	source, ok := lookup(db.InitGetBindData(info)).(*TableSource)
+	if !ok {
+		setCallbackError(db.InitSetError, info, "table function is not bound")
+		return
+	}
+
+	if err := source.init(db, info); err != nil {
+		setCallbackError(db.InitSetError, info, err.Error())
+	}
+}
+
+// init starts a scan of the columns requested by DuckDB
+func (s *TableSource) init(db *DB, info DuckDBInitInfo) (err error) {
+	defer recoverError("scan", &err)
+
+	// Without projection pushdown DuckDB requests every column
+	columns := make([]int, db.InitGetColumnCount(info))
+	for i := range columns {
+		index := db.InitGetColumnIndex(info, int64(i))
+		if index >= uint64(len(s.Columns)) {
+			// Virtual columns like the row id
+			columns[i] = -1
+			continue
+		}
+		columns[i] = int(index)
+	}
+
+	scanned := make([]int, 0, len(columns))
+	for _, column := range columns {
+		if column >= 0 {
+			scanned = append(scanned, column)
+		}
+	}
+
+	rows, err := s.Scan(scanned)
+	if err != nil {
+		return err
+	}
+
+	scan := &tableScan{
+		rows:    rows,
+		columns: columns,
+		row:     make([]any, len(scanned)),
+	}
+	db.InitSetInitData(info, register(db, scan), callbacks.deleteData)
+	return nil
+}
+
+// tableFunctionCallback is called by DuckDB to fill the next chunk of a scan
+func tableFunctionCallback(info DuckDBFunctionInfo, output DuckDBDataChunk) {
+	db := callbackDB()
+	scan, ok := lookup(db.FunctionGetInitData(info)).(*tableScan)
+	if !ok {
+		setCallbackError(db.FunctionSetError, info, "table function scan is not initialized")
+		return
+	}
+
+	if err := scan.fill(db, output); err != nil {
+		setCallbackError(db.FunctionSetError, info, err.Error())
+	}
+}
+
+// fill produces up to one vector of rows into the output chunk.
+// An empty chunk tells DuckDB that the scan is complete.
+func (s *tableScan) fill(db *DB, output DuckDBDataChunk) (err error) {
+	defer recoverError("scan", &err)
+
+	if s.done {
+		db.DataChunkSetSize(output, 0)
+		return nil
+	}
+	if s.readers == nil {
+		s.readers = make([]*ValueReader, len(s.columns))
+		for i := range s.readers {
+			handle := db.DataChunkGetVector(output, int64(i))
+			s.readers[i] = NewValueReader(db, db.VectorGetLogicalColumnType(handle))
+		}
+	}
+	vectors := make([]*Vector, len(s.columns))
+	for i, reader := range s.readers {
+		vectors[i] = reader.Vector(db.DataChunkGetVector(output, int64(i)))
+	}
+
+	capacity := int(db.VectorSize())
+	size := 0
+	for ; size < capacity; size++ {
+		clear(s.row)
+		more, err := s.rows.Next(s.row)
+		if err != nil {
+			return err
+		}
+		if !more {
+			s.done = true
+			break
+		}
+
+		value := 0
+		for i, column := range s.columns {
+			if column < 0 {
+				vectors[i].SetNull(size)
+				continue
+			}
+			if err := vectors[i].SetValue(size, s.row[value]); err != nil {
+				return fmt.Errorf("invalid value of column %d: %w", column, err)
+			}
+			value++
+		}
+	}
+
+	db.DataChunkSetSize(output, int64(size))
+	return nil
+}
+
+// setCallbackError reports an error from a callback to DuckDB, which copies the message
+func setCallbackError[I any](setError func(I, *byte), info I, message string) {
+	cMessage := ToCString(message)
+	defer FreeCString(cMessage)
+	setError(info, cMessage)
+}
@@ -0,0 +1,182 @@
+package duckdb
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+)
+
+// sliceRows produces rows from a slice, recording the scanned columns
+type sliceRows struct {
+	rows [][]any
+	next int
+}
+
+func (r *sliceRows) Next(row []any) (bool, error) {
+	if r.next >= len(r.rows) {
+		return false, nil
+	}
+	copy(row, r.rows[r.next])
+	r.next++
+	return true, nil
+}
+
+// testChunkDB creates a mock DB whose data chunk has one vector per column type,
+// backed by Go memory
+func testChunkDB(types []DuckDBType, data [][]int64, validity [][]uint64, vectorSize int64, size *int64) *DB {
+	db := TestDB()
+	db.DataChunkGetVector = func(_ DuckDBDataChunk, col int64) DuckDBVector {
+		return DuckDBVector(&types[col])
+	}
+	db.VectorGetLogicalColumnType = func(v DuckDBVector) DuckDBLogicalType {
+		return DuckDBLogicalType(v)
+	}
+	db.GetTypeID = func(lt DuckDBLogicalType) DuckDBType { return *(*DuckDBType)(lt) }
+	db.DestroyLogicalType = func(*DuckDBLogicalType) {}
+	column := func(v DuckDBVector) int {
+		return int((uintptr(unsafe.Pointer(v)) - uintptr(unsafe.Pointer(&types[0]))) / unsafe.Sizeof(types[0]))
+	}
+	db.VectorGetData = func(v DuckDBVector) unsafe.Pointer {
+		return unsafe.Pointer(&data[column(v)][0])
+	}
+	db.VectorGetValidity = func(DuckDBVector) *uint64 { return nil }
+	db.VectorEnsureValidityWritable = func(v DuckDBVector) {
+		validity[column(v)][0] = ^uint64(0)
+	}
+	db.VectorGetValidity = func(v DuckDBVector) *uint64 {
+		if validity[column(v)][0] == 0 {
+			return nil
+		}
+		return &validity[column(v)][0]
+	}
+	db.VectorSize = func() int64 { return vectorSize }
+	db.DataChunkSetSize = func(_ DuckDBDataChunk, n int64) { *size = n }
+	return db
+}
+
+func TestTableScanFill(t *testing.T) {
+	// DuckDB asks for column 1 of the source and the row id
+	types := []DuckDBType{DuckDBTypeBigint, DuckDBTypeBigint}
+	data := [][]int64{make([]int64, 2), make([]int64, 2)}
+	validity := [][]uint64{{0}, {0}}
+	var size int64
+	db := testChunkDB(types, data, validity, 2, &size)
+
+	rows := &sliceRows{rows: [][]any{{10}, {nil}, {30}}}
+	scan := &tableScan{
+		rows:    rows,
+		columns: []int{1, -1},
+		row:     make([]any, 1),
+	}
+
+	if err := scan.fill(db, nil); err != nil {
+		t.Fatalf("fill failed: %v", err)
+	}
+	if size != 2 {
+		t.Errorf("size = %d, want 2", size)
+	}
+	if data[0][0] != 10 {
+		t.Errorf("data[0][0] = %d, want 10", data[0][0])
+	}
+	if validity[0][0]&0b10 != 0 {
+		t.Errorf("row 1 of column 0 should be NULL")
+	}
+	if validity[1][0]&0b11 != 0 {
+		t.Errorf("row id column should be NULL")
+	}
+
+	// The last row, then an empty chunk to end the scan
+	validity[0][0], validity[1][0] = 0, 0
+	if err := scan.fill(db, nil); err != nil {
+		t.Fatalf("fill failed: %v", err)
+	}
+	if size != 1 || data[0][0] != 30 {
+		t.Errorf("size = %d, data[0][0] = %d, want 1 row with 30", size, data[0][0])
+	}
+	if err := scan.fill(db, nil); err != nil {
+		t.Fatalf("fill failed: %v", err)
+	}
+	if size != 0 {
+		t.Errorf("size = %d, want 0 at the end of the scan", size)
+	}
+}
+
+// exhaustedRows fails the test if Next is called after it returned false
+type exhaustedRows struct {
+	t    *testing.T
+	next int
+	done bool
+}
+
+func (r *exhaustedRows) Next(row []any) (bool, error) {
+	if r.done {
+		r.t.Errorf("Next called after returning false")
+	}
+	if r.next >= 3 {
+		r.done = true
+		return false, nil
+	}
+	row[0] = int64(r.next)
+	r.next++
+	return true, nil
+}
+
+func TestTableScanFillExhausted(t *testing.T) {
+	types := []DuckDBType{DuckDBTypeBigint}
+	var size int64
+	db := testChunkDB(types, [][]int64{make([]int64, 4)}, [][]uint64{{0}}, 4, &size)
+
+	// The partial chunk ends the rows; later calls return empty chunks
+	scan := &tableScan{rows: &exhaustedRows{t: t}, columns: []int{0}, row: make([]any, 1)}
+	for i, want := range []int64{3, 0, 0} {
+		if err := scan.fill(db, nil); err != nil {
+			t.Fatalf("fill %d failed: %v", i, err)
+		}
+		if size != want {
+			t.Errorf("fill %d: size = %d, want %d", i, size, want)
+		}
+	}
+}
+
+type failingRows struct{}
+
+func (failingRows) Next([]any) (bool, error) {
+	return false, errors.New("source failed")
+}
+
+func TestTableScanFillError(t *testing.T) {
+	types := []DuckDBType{DuckDBTypeBigint}
+	var size int64
+	db := testChunkDB(types, [][]int64{make([]int64, 2)}, [][]uint64{{0}}, 2, &size)
+
+	scan := &tableScan{rows: failingRows{}, columns: []int{0}, row: make([]any, 1)}
+	if err := scan.fill(db, nil); err == nil || err.Error() != "source failed" {
+		t.Errorf("fill error = %v, want source failed", err)
+	}
+
+	// Values that do not fit the column type are reported
+	scan = &tableScan{rows: &sliceRows{rows: [][]any{{"x"}}}, columns: []int{0}, row: make([]any, 1)}
+	if err := scan.fill(db, nil); err == nil {
+		t.Errorf("fill succeeded, want conversion error")
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	closed := false
+	id := register(nil, &closer{closed: &closed})
+	if _, ok := lookup(id).(*closer); !ok {
+		t.Fatalf("lookup(%d) did not return the registered object", id)
+	}
+
+	unregister(id)
+	if lookup(id) != nil {
+		t.Errorf("lookup(%d) returned an unregistered object", id)
+	}
+	if !closed {
+		t.Errorf("unregister did not close the object")
+	}
+}
+
+type closer struct{ closed *bool }
+
+func (c *closer) close() { *c.closed = true }
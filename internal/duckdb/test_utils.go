@@ -20,6 +20,7 @@ func TestDB() *DB {
 		Handle:         mockDuckDBDatabase,
 		Connect:        func(DuckDBDatabase, *DuckDBConnection) DuckDBState { return DuckDBSuccess },
 		Close:          func(*DuckDBDatabase) {},
+		Disconnect:     func(*DuckDBConnection) {},
 		Query:          func(DuckDBConnection, *byte, *DuckDBResultRaw) DuckDBState { return DuckDBSuccess },
 		ColumnCount:    func(*DuckDBResultRaw) int64 { return 0 },
 		RowCount:       func(*DuckDBResultRaw) int64 { return 0 },
@@ -28,6 +29,7 @@ func TestDB() *DB {
 		ValueDate:      func(*DuckDBResultRaw, int64, int32) int32 { return 0 },
 		ValueTime:      func(*DuckDBResultRaw, int64, int32) int64 { return 0 },
 		ValueTimestamp: func(*DuckDBResultRaw, int64, int32) int64 { return 0 },
+		ValueNull:      func(*DuckDBResultRaw, int64, int32) bool { return false },
 		DestroyResult:  func(*DuckDBResultRaw) {},
 	}
 }
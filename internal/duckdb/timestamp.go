@@ -0,0 +1,150 @@
+package duckdb
+
+import (
+	"time"
+
+	"github.com/fpt/go-pduckdb/internal/convert"
+	"github.com/pkg/errors"
+)
+
+// SetLocation sets the time zone that TIMESTAMP WITH TIME ZONE values are returned in,
+// including those nested in other types. The default is UTC.
+func (vr *ValueReader) SetLocation(loc *time.Location) {
+	vr.location = loc
+	for _, child := range vr.children {
+		child.SetLocation(loc)
+	}
+}
+
+// timestampTZ converts microseconds since epoch to a time.Time in the reader's time zone
+func (vr *ValueReader) timestampTZ(micros int64) time.Time {
+	t := timestampFromMicros(micros)
+	if vr.location == nil || convert.Infinity(t) != 0 {
+		return t
+	}
+	return t.In(vr.location)
+}
+
+// timestampFromUnits converts the storage of TIMESTAMP_S, TIMESTAMP_MS and TIMESTAMP_NS to a time.Time
+func timestampFromUnits(units int64, typeID DuckDBType) time.Time {
+	if t, ok := timestampInfinity(units); ok {
+		return t
+	}
+	switch typeID {
+	case DuckDBTypeTimestampS:
+		return time.Unix(units, 0).UTC()
+	case DuckDBTypeTimestampMS:
+		return time.UnixMilli(units).UTC()
+	case DuckDBTypeTimestampNS:
+		return time.Unix(0, units).UTC()
+	default:
+		return timestampFromMicros(units)
+	}
+}
+
+// timestampInfinity returns the infinite time for DuckDB's infinite timestamp sentinels,
+// which are the same in every timestamp unit
+func timestampInfinity(units int64) (time.Time, bool) {
+	switch units {
+	case convert.TimestampInfinity:
+		return convert.InfinityTime, true
+	case convert.TimestampNegativeInfinity:
+		return convert.NegativeInfinityTime, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// timestampUnits converts t to the storage of the given timestamp or time type
+// Infinite times are stored as the infinite timestamp sentinels.
+func timestampUnits(t time.Time, typeID DuckDBType) int64 {
+	if typeID != DuckDBTypeTimeTZ {
+		switch convert.Infinity(t) {
+		case 1:
+			return convert.TimestampInfinity
+		case -1:
+			return convert.TimestampNegativeInfinity
+		}
+	}
+	switch typeID {
+	case DuckDBTypeTimestampS:
+		return t.Unix()
+	case DuckDBTypeTimestampMS:
+		return t.UnixMilli()
+	case DuckDBTypeTimestampNS:
+		return t.UnixNano()
+	case DuckDBTypeTimeTZ:
+		return int64(timeTZBits(t))
+	default:
+		return t.UnixMicro()
+	}
+}
+
+// timeTZFromBits converts a TIME WITH TIME ZONE to a time of day on 1970-01-01 with its UTC offset
+func timeTZFromBits(bits uint64) time.Time {
+	micros, offset := splitTimeTZ(bits)
+	zone := time.FixedZone(formatOffset(offset), offset)
+	return time.Date(1970, 1, 1, 0, 0, 0, 0, zone).Add(time.Duration(micros) * time.Microsecond)
+}
+
+// timeTZBits encodes the time of day and UTC offset of t as a TIME WITH TIME ZONE,
+// the inverse of splitTimeTZ
+func timeTZBits(t time.Time) uint64 {
+	const maxOffset = 16*60*60 - 1
+	hour, minute, second := t.Clock()
+	micros := int64(hour)*3_600_000_000 + int64(minute)*60_000_000 + int64(second)*1_000_000 + int64(t.Nanosecond()/1000)
+	_, offset := t.Zone()
+	return uint64(micros)<<24 | uint64(maxOffset-offset)
+}
+
+// temporalText converts a value to the text DuckDB casts to the given timestamp or time type.
+// Times are formatted with all the precision and zone information the type can hold,
+// strings are passed through for DuckDB to parse.
+func temporalText(value any, typeID DuckDBType) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+
+	t, err := convert.ToTimestamp(value)
+	if err != nil {
+		return "", err
+	}
+
+	if typeID != DuckDBTypeTimeTZ {
+		if text, ok := infinityText(t); ok {
+			return text, nil
+		}
+	}
+
+	switch typeID {
+	case DuckDBTypeTimestampTZ:
+		return t.Format("2006-01-02 15:04:05.999999-07:00"), nil
+	case DuckDBTypeTimeTZ:
+		return t.Format("15:04:05.999999-07:00"), nil
+	case DuckDBTypeTimestampS, DuckDBTypeTimestampMS, DuckDBTypeTimestampNS:
+		// Timestamps without a time zone hold the time in UTC, like TIMESTAMP
+		return t.UTC().Format("2006-01-02 15:04:05.999999999"), nil
+	default:
+		return "", errors.Errorf("%s is not a timestamp type", typeID)
+	}
+}
+
+// infinityText returns DuckDB's text for an infinite date or timestamp
+func infinityText(t time.Time) (string, bool) {
+	switch convert.Infinity(t) {
+	case 1:
+		return "infinity", true
+	case -1:
+		return "-infinity", true
+	default:
+		return "", false
+	}
+}
+
+// formatTimestamp formats a date or timestamp with the layout, or as DuckDB's text for infinite values
+func formatTimestamp(t time.Time, layout string) string {
+	if text, ok := infinityText(t); ok {
+		return text
+	}
+	return t.Format(layout)
+}
@@ -0,0 +1,156 @@
+package duckdb
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/fpt/go-pduckdb/internal/convert"
+)
+
+func TestVectorTimestampVariants(t *testing.T) {
+	want := time.Date(2024, 3, 1, 12, 34, 56, 123_456_789, time.UTC)
+
+	tests := []struct {
+		name   string
+		typeID DuckDBType
+		value  int64
+		want   time.Time
+	}{
+		{"seconds", DuckDBTypeTimestampS, want.Unix(), want.Truncate(time.Second)},
+		{"milliseconds", DuckDBTypeTimestampMS, want.UnixMilli(), want.Truncate(time.Millisecond)},
+		{"nanoseconds", DuckDBTypeTimestampNS, want.UnixNano(), want},
+		{"time zone", DuckDBTypeTimestampTZ, want.UnixMicro(), want.Truncate(time.Microsecond)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := testVector(tt.typeID, []int64{tt.value, 0}, nil)
+			got, ok := v.Value(0).(time.Time)
+			if !ok || !got.Equal(tt.want) || got.Location() != time.UTC {
+				t.Errorf("Value(0) = %v, want %v", v.Value(0), tt.want)
+			}
+
+			// Values are stored in the unit of the type
+			if err := v.SetValue(1, want); err != nil {
+				t.Fatalf("SetValue failed: %v", err)
+			}
+			if got := v.Value(1).(time.Time); !got.Equal(tt.want) {
+				t.Errorf("Value(1) = %v after SetValue, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVectorTimestampTZLocation(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+
+	instant := time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)
+	v := testVector(DuckDBTypeTimestampTZ, []int64{instant.UnixMicro()}, nil)
+	v.reader.SetLocation(berlin)
+
+	got := v.Value(0).(time.Time)
+	if !got.Equal(instant) || got.Location() != berlin || got.Hour() != 12 {
+		t.Errorf("Value(0) = %v, want %v in Europe/Berlin", got, instant)
+	}
+}
+
+func TestVectorTimeTZ(t *testing.T) {
+	zone := time.FixedZone("", -(5*3600 + 30*60))
+	input := time.Date(2024, 1, 1, 23, 15, 30, 500_000_000, zone)
+
+	v := testVector(DuckDBTypeTimeTZ, make([]uint64, 1), nil)
+	if err := v.SetValue(0, input); err != nil {
+		t.Fatalf("SetValue failed: %v", err)
+	}
+
+	got := v.Value(0).(time.Time)
+	_, offset := got.Zone()
+	hour, minute, second := got.Clock()
+	if hour != 23 || minute != 15 || second != 30 || got.Nanosecond() != 500_000_000 || offset != -(5*3600+30*60) {
+		t.Errorf("Value(0) = %v, want 23:15:30.5-05:30", got)
+	}
+	if got.Year() != 1970 {
+		t.Errorf("Value(0) = %v, want a time on 1970-01-01", got)
+	}
+	if text := v.text(0); text != "23:15:30.5-05:30" {
+		t.Errorf("text(0) = %v, want 23:15:30.5-05:30", text)
+	}
+}
+
+func TestTemporalText(t *testing.T) {
+	zone := time.FixedZone("", 2*3600)
+	ts := time.Date(2024, 3, 1, 12, 34, 56, 123_456_789, zone)
+
+	tests := []struct {
+		typeID   DuckDBType
+		value    any
+		expected string
+	}{
+		{DuckDBTypeTimestampNS, ts, "2024-03-01 10:34:56.123456789"},
+		{DuckDBTypeTimestampS, ts, "2024-03-01 10:34:56.123456789"},
+		{DuckDBTypeTimestampTZ, ts, "2024-03-01 12:34:56.123456+02:00"},
+		{DuckDBTypeTimeTZ, ts, "12:34:56.123456+02:00"},
+		{DuckDBTypeTimestampTZ, "2024-03-01 12:00:00 Europe/Berlin", "2024-03-01 12:00:00 Europe/Berlin"},
+		{DuckDBTypeTimestampNS, convert.InfinityTime, "infinity"},
+		{DuckDBTypeTimestampTZ, convert.NegativeInfinityTime, "-infinity"},
+	}
+
+	for _, tt := range tests {
+		got, err := temporalText(tt.value, tt.typeID)
+		if err != nil || got != tt.expected {
+			t.Errorf("temporalText(%v, %s) = %v, %v, want %v", tt.value, tt.typeID, got, err, tt.expected)
+		}
+	}
+
+	if _, err := temporalText(42, DuckDBTypeTimestampNS); err == nil {
+		t.Errorf("temporalText(42) succeeded, want error")
+	}
+}
+
+func TestVectorInfinity(t *testing.T) {
+	tests := []struct {
+		name   string
+		vector *Vector
+	}{
+		{"date", testVector(DuckDBTypeDate, []int32{math.MaxInt32, -math.MaxInt32, 0}, nil)},
+		{"timestamp", testVector(DuckDBTypeTimestamp, []int64{math.MaxInt64, -math.MaxInt64, 0}, nil)},
+		{"seconds", testVector(DuckDBTypeTimestampS, []int64{math.MaxInt64, -math.MaxInt64, 0}, nil)},
+		{"nanoseconds", testVector(DuckDBTypeTimestampNS, []int64{math.MaxInt64, -math.MaxInt64, 0}, nil)},
+		{"time zone", testVector(DuckDBTypeTimestampTZ, []int64{math.MaxInt64, -math.MaxInt64, 0}, nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.vector.Value(0); got != convert.InfinityTime {
+				t.Errorf("Value(0) = %v, want InfinityTime", got)
+			}
+			if got := tt.vector.Value(1); got != convert.NegativeInfinityTime {
+				t.Errorf("Value(1) = %v, want NegativeInfinityTime", got)
+			}
+			if got, want := tt.vector.text(0), "infinity"; got != want {
+				t.Errorf("text(0) = %v, want %v", got, want)
+			}
+			if got, want := tt.vector.text(1), "-infinity"; got != want {
+				t.Errorf("text(1) = %v, want %v", got, want)
+			}
+
+			// Infinite times round trip through the sentinels, including from text
+			if err := tt.vector.SetValue(2, "infinity"); err != nil {
+				t.Fatalf("SetValue failed: %v", err)
+			}
+			if got := tt.vector.Value(2); got != convert.InfinityTime {
+				t.Errorf("Value(2) = %v after SetValue, want InfinityTime", got)
+			}
+			if err := tt.vector.SetValue(2, convert.NegativeInfinityTime); err != nil {
+				t.Fatalf("SetValue failed: %v", err)
+			}
+			if got := tt.vector.Value(2); got != convert.NegativeInfinityTime {
+				t.Errorf("Value(2) = %v after SetValue, want NegativeInfinityTime", got)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@
 package duckdb
 
 import (
+	"math/big"
 	"reflect"
 	"time"
 	"unsafe"
@@ -17,6 +18,7 @@ const (
 	DuckDBError DuckDBState = 1
 )
 
+// DuckDBErrorType classifies errors reported by DuckDB
 type DuckDBErrorType int32
 
 const (
@@ -108,6 +110,61 @@ const (
 	DuckDBInvalidConfiguration DuckDBErrorType = 42
 )
 
+// errorTypeNames maps error types to the names DuckDB uses as message prefixes
+var errorTypeNames = map[DuckDBErrorType]string{
+	DuckDBErrorInvalid:              "Invalid",
+	DuckDBErrorOutOfRange:           "Out of Range",
+	DuckDBErrorConversion:           "Conversion",
+	DuckDBErrorUnknownType:          "Unknown Type",
+	DuckDBErrorDecimal:              "Decimal",
+	DuckDBErrorMismatchType:         "Mismatch Type",
+	DuckDBErrorDivideByZero:         "Divide by Zero",
+	DuckDBErrorObjectSize:           "Object Size",
+	DuckDBErrorInvalidType:          "Invalid type",
+	DuckDBErrorSerialization:        "Serialization",
+	DuckDBErrorTransaction:          "TransactionContext",
+	DuckDBErrorNotImplemented:       "Not implemented",
+	DuckDBErrorExpression:           "Expression",
+	DuckDBErrorCatalog:              "Catalog",
+	DuckDBErrorParser:               "Parser",
+	DuckDBErrorPlanner:              "Planner",
+	DuckDBErrorScheduler:            "Scheduler",
+	DuckDBErrorExecutor:             "Executor",
+	DuckDBErrorConstraint:           "Constraint",
+	DuckDBErrorIndex:                "Index",
+	DuckDBErrorStat:                 "Stat",
+	DuckDBErrorConnection:           "Connection",
+	DuckDBErrorSyntax:               "Syntax",
+	DuckDBErrorSettings:             "Settings",
+	DuckDBErrorBinder:               "Binder",
+	DuckDBErrorNetwork:              "Network",
+	DuckDBErrorOptimizer:            "Optimizer",
+	DuckDBErrorNullPointer:          "NullPointer",
+	DuckDBErrorIO:                   "IO",
+	DuckDBErrorInterrupt:            "INTERRUPT",
+	DuckDBErrorFatal:                "FATAL",
+	DuckDBErrorInternal:             "INTERNAL",
+	DuckDBErrorInvalidInput:         "Invalid Input",
+	DuckDBErrorOutOfMemory:          "Out of Memory",
+	DuckDBErrorPermission:           "Permission",
+	DuckDBErrorParameterNotResolved: "Parameter Not Resolved",
+	DuckDBErrorParameterNotAllowed:  "Parameter Not Allowed",
+	DuckDBErrorDependency:           "Dependency",
+	DuckDBErrorHTTP:                 "HTTP",
+	DuckDBErrorMissingExtension:     "Missing Extension",
+	DuckDBErrorAutoload:             "Extension Autoloading",
+	DuckDBErrorSequence:             "Sequence",
+	DuckDBInvalidConfiguration:      "Invalid Configuration",
+}
+
+// String returns the name DuckDB uses for the error type
+func (t DuckDBErrorType) String() string {
+	if name, ok := errorTypeNames[t]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
 // DuckDBResultRaw is the raw C structure for DuckDB query results
 type DuckDBResultRaw struct {
 	DeprecatedColumnCount  int64
@@ -197,17 +254,17 @@ func (t DuckDBType) GoType() reflect.Type {
 	case DuckDBTypeTime:
 		return reflect.TypeOf(time.Time{})
 	case DuckDBTypeInterval:
-		return reflect.TypeOf(int64(0))
+		return reflect.TypeOf(Interval{})
 	case DuckDBTypeHugeint:
-		return reflect.TypeOf(int64(0))
+		return reflect.TypeOf((*big.Int)(nil))
 	case DuckDBTypeUHugeint:
-		return reflect.TypeOf(uint64(0))
+		return reflect.TypeOf((*big.Int)(nil))
 	case DuckDBTypeVarchar:
 		return reflect.TypeOf("")
 	case DuckDBTypeBlob:
 		return reflect.TypeOf([]byte{})
 	case DuckDBTypeDecimal:
-		return reflect.TypeOf(float64(0))
+		return reflect.TypeOf(Decimal{})
 	case DuckDBTypeTimestampS:
 		return reflect.TypeOf(time.Time{})
 	case DuckDBTypeTimestampMS:
@@ -225,11 +282,11 @@ func (t DuckDBType) GoType() reflect.Type {
 	case DuckDBTypeArray:
 		return reflect.TypeOf([]any{})
 	case DuckDBTypeUUID:
-		return reflect.TypeOf([16]byte{})
+		return reflect.TypeOf(UUID{})
 	case DuckDBTypeUnion:
-		return reflect.TypeOf([]any{})
+		return reflect.TypeOf(Union{})
 	case DuckDBTypeBit:
-		return reflect.TypeOf([]byte{})
+		return reflect.TypeOf(BitString{})
 	case DuckDBTypeTimeTZ:
 		return reflect.TypeOf(time.Time{})
 	case DuckDBTypeTimestampTZ:
@@ -237,7 +294,7 @@ func (t DuckDBType) GoType() reflect.Type {
 	case DuckDBTypeAny:
 		return reflect.TypeOf(any(nil))
 	case DuckDBTypeVarInt:
-		return reflect.TypeOf(int64(0))
+		return reflect.TypeOf((*big.Int)(nil))
 	case DuckDBTypeSQLNull:
 		return reflect.TypeOf(nil)
 	case DuckDBTypeStringLiteral:
@@ -347,9 +404,33 @@ type DuckDBPreparedStatement unsafe.Pointer
 // DuckDBDatabase represents a DuckDB database
 type DuckDBDatabase unsafe.Pointer
 
+// DuckDBConfig represents the configuration a DuckDB database is opened with
+type DuckDBConfig unsafe.Pointer
+
 // DuckDBLogicalType represents a DuckDB logical type
 type DuckDBLogicalType unsafe.Pointer
 
+// DuckDBAppender represents a DuckDB appender
+type DuckDBAppender unsafe.Pointer
+
+// DuckDBScalarFunction represents a DuckDB scalar function
+type DuckDBScalarFunction unsafe.Pointer
+
+// DuckDBFunctionInfo represents the state passed to the callbacks of a function
+type DuckDBFunctionInfo unsafe.Pointer
+
+// DuckDBTableFunction represents a DuckDB table function
+type DuckDBTableFunction unsafe.Pointer
+
+// DuckDBBindInfo represents the state passed to the bind callback of a table function
+type DuckDBBindInfo unsafe.Pointer
+
+// DuckDBInitInfo represents the state passed to the init callback of a table function
+type DuckDBInitInfo unsafe.Pointer
+
+// DuckDBExtractedStatements represents the statements extracted from a query string
+type DuckDBExtractedStatements unsafe.Pointer
+
 // DuckDBStatementType represents the type of a DuckDB statement
 type DuckDBStatementType int32
 
@@ -0,0 +1,68 @@
+package duckdb
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Union is a value of a DuckDB UNION: the tag of its member and the member's value
+type Union struct {
+	Tag   string
+	Value any
+}
+
+// unionValue decodes the UNION at the given row from its tag and member vectors
+func (v *Vector) unionValue(row int) Union {
+	tag := get[uint8](v.tags, row)
+	return Union{Tag: v.reader.childNames[tag], Value: v.children[tag].Value(row)}
+}
+
+// UnionMembers returns the member tags of a UNION, or nil for other types
+func (vr *ValueReader) UnionMembers() []string {
+	if vr.typeID != DuckDBTypeUnion {
+		return nil
+	}
+	return append([]string(nil), vr.childNames...)
+}
+
+// toUnion converts a Go value to a Union
+func toUnion(value any) (Union, error) {
+	switch v := value.(type) {
+	case Union:
+		return v, nil
+	case *Union:
+		return *v, nil
+	default:
+		return Union{}, errors.Errorf("cannot convert %T to UNION, expected a Union with the tag of a member", value)
+	}
+}
+
+// unionMemberType returns the logical type of the UNION member with the given tag.
+// The caller destroys the returned type.
+func unionMemberType(db *DB, logicalType DuckDBLogicalType, tag string) (DuckDBLogicalType, error) {
+	count := db.UnionTypeMemberCount(logicalType)
+	tags := make([]string, count)
+	for i := int64(0); i < count; i++ {
+		tags[i] = db.takeString(db.UnionTypeMemberName(logicalType, i))
+		if tags[i] == tag {
+			return db.UnionTypeMemberType(logicalType, i), nil
+		}
+	}
+	return nil, errors.Errorf("invalid UNION tag %q, expected one of %s", tag, strings.Join(tags, ", "))
+}
+
+// formatIdentifier renders a STRUCT or UNION member name like DuckDB,
+// quoting it unless it is a lower case identifier
+func formatIdentifier(name string) string {
+	for i, c := range name {
+		if c == '_' || (c >= 'a' && c <= 'z') || (i > 0 && c >= '0' && c <= '9') {
+			continue
+		}
+		return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+	}
+	if name == "" {
+		return `""`
+	}
+	return name
+}
@@ -0,0 +1,64 @@
+package duckdb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVectorUnion(t *testing.T) {
+	nums := testVector(DuckDBTypeInteger, []int32{42, 0, 0}, nil)
+	strs := testVector(DuckDBTypeVarchar, make([]uint64, 6), []uint64{0b000})
+	tags := []uint8{0, 1, 0}
+
+	v := &Vector{
+		reader: &ValueReader{
+			typeID:     DuckDBTypeUnion,
+			childNames: []string{"num", "Str"},
+			children:   []*ValueReader{nums.reader, strs.reader},
+		},
+		children: []*Vector{nums, strs},
+	}
+	v.tags = testVector(DuckDBTypeUTinyint, tags, nil).data
+
+	if got := v.Value(0); got != (Union{Tag: "num", Value: int32(42)}) {
+		t.Errorf("Value(0) = %#v, want num 42", got)
+	}
+	if got := v.Value(1); got != (Union{Tag: "Str"}) {
+		t.Errorf("Value(1) = %#v, want a NULL Str member", got)
+	}
+	if got := v.reader.TypeName(); got != `UNION(num INTEGER, "Str" VARCHAR)` {
+		t.Errorf("TypeName() = %v", got)
+	}
+	if got := v.reader.UnionMembers(); !reflect.DeepEqual(got, []string{"num", "Str"}) {
+		t.Errorf("UnionMembers() = %v", got)
+	}
+	if got := (&ValueReader{typeID: DuckDBTypeInteger}).UnionMembers(); got != nil {
+		t.Errorf("UnionMembers() = %v for INTEGER, want nil", got)
+	}
+}
+
+func TestToUnion(t *testing.T) {
+	u := Union{Tag: "num", Value: 1}
+	if got, err := toUnion(&u); err != nil || got != u {
+		t.Errorf("toUnion(*Union) = %v, %v", got, err)
+	}
+	if _, err := toUnion(1); err == nil {
+		t.Errorf("toUnion(1) succeeded, want error")
+	}
+}
+
+func TestFormatIdentifier(t *testing.T) {
+	tests := map[string]string{
+		"model_name": "model_name",
+		"Model":      `"Model"`,
+		"1st":        `"1st"`,
+		"v2":         "v2",
+		`a"b c`:      `"a""b c"`,
+		"":           `""`,
+	}
+	for name, expected := range tests {
+		if got := formatIdentifier(name); got != expected {
+			t.Errorf("formatIdentifier(%q) = %v, want %v", name, got, expected)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package duckdb
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// UUID is a DuckDB UUID, in big-endian byte order
+type UUID [16]byte
+
+// ParseUUID parses a UUID like "6ba7b810-9dad-11d1-80b4-00c04fd430c8".
+// Like DuckDB, it also accepts UUIDs without hyphens and in braces.
+func ParseUUID(s string) (UUID, error) {
+	text := strings.TrimSpace(s)
+	if strings.HasPrefix(text, "{") && strings.HasSuffix(text, "}") {
+		text = text[1 : len(text)-1]
+	}
+	if len(text) == 36 {
+		if text[8] != '-' || text[13] != '-' || text[18] != '-' || text[23] != '-' {
+			return UUID{}, errors.Errorf("invalid UUID %q", s)
+		}
+		text = text[0:8] + text[9:13] + text[14:18] + text[19:23] + text[24:]
+	}
+
+	var u UUID
+	if len(text) != 32 {
+		return UUID{}, errors.Errorf("invalid UUID %q", s)
+	}
+	if _, err := hex.Decode(u[:], []byte(text)); err != nil {
+		return UUID{}, errors.Errorf("invalid UUID %q", s)
+	}
+	return u, nil
+}
+
+// String returns the UUID in its canonical form, like "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+func (u UUID) String() string {
+	s := hex.EncodeToString(u[:])
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:32]
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (u *UUID) UnmarshalText(text []byte) error {
+	parsed, err := ParseUUID(string(text))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+// Value implements driver.Valuer.
+// The UUID is passed in its text representation, which DuckDB casts to UUID.
+func (u UUID) Value() (driver.Value, error) {
+	return u.String(), nil
+}
+
+// Scan implements sql.Scanner
+func (u *UUID) Scan(src any) error {
+	if src == nil {
+		return errors.New("cannot scan NULL into UUID")
+	}
+
+	uuid, err := toUUID(src)
+	if err != nil {
+		return err
+	}
+	*u = uuid
+	return nil
+}
+
+// toUUID converts a Go value to a UUID.
+// Byte slices of length 16 are taken as the raw UUID, other byte slices as text.
+func toUUID(value any) (UUID, error) {
+	switch v := value.(type) {
+	case UUID:
+		return v, nil
+	case *UUID:
+		return *v, nil
+	case [16]byte:
+		return UUID(v), nil
+	case string:
+		return ParseUUID(v)
+	case []byte:
+		if len(v) == 16 {
+			return UUID(v), nil
+		}
+		return ParseUUID(string(v))
+	case fmt.Stringer:
+		return ParseUUID(v.String())
+	default:
+		return UUID{}, errors.Errorf("cannot convert %T to UUID", value)
+	}
+}
+
+// uuidFromHugeint decodes a UUID stored as a hugeint.
+// DuckDB flips the top bit of the upper half so that UUIDs sort correctly as signed integers.
+func uuidFromHugeint(h hugeint) UUID {
+	var u UUID
+	upper := h.upper ^ (1 << 63)
+	for i := 0; i < 8; i++ {
+		u[i] = byte(upper >> (56 - 8*i))
+		u[8+i] = byte(h.lower >> (56 - 8*i))
+	}
+	return u
+}
+
+// hugeint encodes the UUID in DuckDB's storage, the inverse of uuidFromHugeint
+func (u UUID) hugeint() hugeint {
+	var h hugeint
+	for i := 0; i < 8; i++ {
+		h.upper = h.upper<<8 | uint64(u[i])
+		h.lower = h.lower<<8 | uint64(u[8+i])
+	}
+	h.upper ^= 1 << 63
+	return h
+}
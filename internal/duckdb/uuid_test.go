@@ -0,0 +1,100 @@
+package duckdb
+
+import (
+	"testing"
+)
+
+func TestParseUUID(t *testing.T) {
+	expected := UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	tests := []struct {
+		input   string
+		wantErr bool
+	}{
+		{input: "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{input: "6BA7B810-9DAD-11D1-80B4-00C04FD430C8"},
+		{input: "6ba7b8109dad11d180b400c04fd430c8"},
+		{input: "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"},
+		{input: "6ba7b810-9dad-11d1-80b4-00c04fd430c", wantErr: true},
+		{input: "6ba7b810_9dad_11d1_80b4_00c04fd430c8", wantErr: true},
+		{input: "zba7b810-9dad-11d1-80b4-00c04fd430c8", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		u, err := ParseUUID(tt.input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseUUID(%q) = %v, want error", tt.input, u)
+			}
+			continue
+		}
+		if err != nil || u != expected {
+			t.Errorf("ParseUUID(%q) = %v, %v, want %v", tt.input, u, err, expected)
+		}
+	}
+
+	if got := expected.String(); got != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+		t.Errorf("String() = %v", got)
+	}
+}
+
+func TestUUIDScan(t *testing.T) {
+	expected, _ := ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	for _, src := range []any{expected, [16]byte(expected), expected[:], expected.String(), []byte(expected.String())} {
+		var u UUID
+		if err := u.Scan(src); err != nil || u != expected {
+			t.Errorf("Scan(%v) = %v, %v, want %v", src, u, err, expected)
+		}
+	}
+
+	var u UUID
+	if err := u.Scan(nil); err == nil {
+		t.Errorf("Scan(nil) succeeded, want error")
+	}
+	if err := u.Scan(42); err == nil {
+		t.Errorf("Scan(42) succeeded, want error")
+	}
+
+	text, err := expected.MarshalText()
+	if err != nil || u.UnmarshalText(text) != nil || u != expected {
+		t.Errorf("text round trip of %v = %v", expected, u)
+	}
+}
+
+func TestUUIDHugeint(t *testing.T) {
+	tests := []struct {
+		h        hugeint
+		expected string
+	}{
+		// DuckDB stores UUIDs with the top bit flipped
+		{hugeint{lower: 1, upper: 1 << 63}, "00000000-0000-0000-0000-000000000001"},
+		{hugeint{lower: 0, upper: 0}, "80000000-0000-0000-0000-000000000000"},
+		{hugeint{lower: ^uint64(0), upper: ^uint64(0) >> 1}, "ffffffff-ffff-ffff-ffff-ffffffffffff"},
+		{hugeint{lower: 0x80b400c04fd430c8, upper: 0xeba7b8109dad11d1}, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+	}
+
+	for _, tt := range tests {
+		u := uuidFromHugeint(tt.h)
+		if u.String() != tt.expected {
+			t.Errorf("uuidFromHugeint(%v) = %v, want %v", tt.h, u, tt.expected)
+		}
+		if u.hugeint() != tt.h {
+			t.Errorf("hugeint() of %v = %v, want %v", u, u.hugeint(), tt.h)
+		}
+	}
+}
+
+func TestVectorUUID(t *testing.T) {
+	v := testVector(DuckDBTypeUUID, make([]hugeint, 1), nil)
+	if err := v.SetValue(0, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"); err != nil {
+		t.Fatalf("SetValue failed: %v", err)
+	}
+	if got, ok := v.Value(0).(UUID); !ok || got.String() != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+		t.Errorf("Value(0) = %v, want the UUID", v.Value(0))
+	}
+	if err := v.SetValue(0, "not a uuid"); err == nil {
+		t.Errorf("SetValue succeeded for an invalid UUID")
+	}
+}
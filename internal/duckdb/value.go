@@ -0,0 +1,353 @@
+package duckdb
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"time"
+
+	"github.com/fpt/go-pduckdb/internal/convert"
+	"github.com/pkg/errors"
+)
+
+// goValue converts a duckdb_value to a Go value, returning nil for NULL.
+// Types without a dedicated getter are returned in their text representation.
+func (db *DB) goValue(v DuckDBValue) any {
+	if v == nil || db.IsNullValue(v) {
+		return nil
+	}
+
+	// The type belongs to the value and must not be destroyed
+	switch db.GetTypeID(db.GetValueType(v)) {
+	case DuckDBTypeBoolean:
+		return db.GetBool(v)
+	case DuckDBTypeTinyint:
+		return db.GetInt8(v)
+	case DuckDBTypeSmallint:
+		return db.GetInt16(v)
+	case DuckDBTypeInteger:
+		return db.GetInt32(v)
+	case DuckDBTypeBigint:
+		return db.GetInt64(v)
+	case DuckDBTypeUTinyint:
+		return db.GetUint8(v)
+	case DuckDBTypeUSmallint:
+		return db.GetUint16(v)
+	case DuckDBTypeUInteger:
+		return db.GetUint32(v)
+	case DuckDBTypeUBigint:
+		return db.GetUint64(v)
+	case DuckDBTypeFloat:
+		return db.GetFloat(v)
+	case DuckDBTypeDouble:
+		return db.GetDouble(v)
+	case DuckDBTypeDate:
+		return dateFromDays(db.GetDate(v))
+	case DuckDBTypeTime:
+		return timeFromMicros(db.GetTime(v))
+	case DuckDBTypeTimestamp:
+		return timestampFromMicros(db.GetTimestamp(v))
+	default:
+		return db.takeString(db.GetVarchar(v))
+	}
+}
+
+// createValue converts a Go value to a duckdb_value for a parameter of the given logical type.
+// Slices, maps and structs become LIST, MAP and STRUCT values, recursively.
+// Other values are created as BOOLEAN, BIGINT, DOUBLE or as their text, which DuckDB
+// casts to the parameter type when the value is bound. Without a logical type,
+// as for parameters whose type DuckDB cannot infer, the type follows the Go value.
+// The caller destroys the value.
+func (db *DB) createValue(value any, logicalType DuckDBLogicalType) (DuckDBValue, error) {
+	if valuer, ok := value.(driver.Valuer); ok {
+		if v := reflect.ValueOf(value); v.Kind() == reflect.Pointer && v.IsNil() {
+			return db.CreateNullValue(), nil
+		}
+		resolved, err := valuer.Value()
+		if err != nil {
+			return nil, err
+		}
+		value = resolved
+	}
+
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer && v.Type() != bigIntType && !v.IsNil() {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Invalid:
+		return db.CreateNullValue(), nil
+	case reflect.Pointer, reflect.Slice, reflect.Map:
+		if v.IsNil() {
+			return db.CreateNullValue(), nil
+		}
+	}
+	value = basicValue(v)
+
+	typeID := DuckDBTypeInvalid
+	if logicalType != nil {
+		typeID = db.GetTypeID(logicalType)
+	}
+
+	switch typeID {
+	case DuckDBTypeList:
+		childType := db.ListTypeChildType(logicalType)
+		defer db.DestroyLogicalType(&childType)
+		return db.createListValue(v, childType)
+
+	case DuckDBTypeArray:
+		size := int(db.ArrayTypeArraySize(logicalType))
+		if (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Len() != size {
+			return nil, errors.Errorf("ARRAY of size %d cannot hold %d values", size, v.Len())
+		}
+		// DuckDB casts the LIST to the ARRAY type
+		childType := db.ArrayTypeChildType(logicalType)
+		defer db.DestroyLogicalType(&childType)
+		return db.createListValue(v, childType)
+
+	case DuckDBTypeStruct:
+		return db.createStructValue(v, logicalType)
+
+	case DuckDBTypeMap:
+		return db.createMapValue(v, logicalType)
+
+	case DuckDBTypeUnion:
+		unionVal, err := toUnion(value)
+		if err != nil {
+			return nil, err
+		}
+		memberType, err := unionMemberType(db, logicalType, unionVal.Tag)
+		if err != nil {
+			return nil, err
+		}
+		defer db.DestroyLogicalType(&memberType)
+		return db.createValue(unionVal.Value, memberType)
+
+	case DuckDBTypeInvalid, DuckDBTypeAny:
+		if IsNestedValue(value) {
+			switch v.Kind() {
+			case reflect.Map:
+				return db.createMapValue(v, nil)
+			case reflect.Struct:
+				return db.createStructValue(v, nil)
+			default:
+				return db.createListValue(v, nil)
+			}
+		}
+	}
+
+	return db.createScalarValue(value, logicalType, typeID)
+}
+
+// createScalarValue creates a BOOLEAN, BIGINT, UBIGINT, DOUBLE or TIMESTAMP value for numeric,
+// boolean and timestamp types, and a VARCHAR holding the text DuckDB casts to the type otherwise
+func (db *DB) createScalarValue(value any, logicalType DuckDBLogicalType, typeID DuckDBType) (DuckDBValue, error) {
+	if typeID == DuckDBTypeInvalid || typeID == DuckDBTypeAny {
+		// The type follows the Go value
+		switch v := value.(type) {
+		case bool:
+			typeID = DuckDBTypeBoolean
+		case int64:
+			typeID = DuckDBTypeBigint
+		case uint64:
+			// Unsigned values above math.MaxInt64 do not fit a BIGINT
+			typeID = DuckDBTypeUBigint
+		case float64:
+			typeID = DuckDBTypeDouble
+		case time.Time:
+			typeID = DuckDBTypeTimestamp
+		default:
+			text, err := convert.ToString(v)
+			if err != nil {
+				return nil, err
+			}
+			return db.CreateVarchar(text), nil
+		}
+	}
+
+	switch typeID {
+	case DuckDBTypeBoolean:
+		b, err := convert.ToBoolean(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert value to BOOLEAN")
+		}
+		return db.CreateBool(b), nil
+
+	case DuckDBTypeTinyint, DuckDBTypeSmallint, DuckDBTypeInteger, DuckDBTypeBigint,
+		DuckDBTypeUTinyint, DuckDBTypeUSmallint, DuckDBTypeUInteger:
+		n, err := convert.ToInt64(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert value to %s", typeID)
+		}
+		return db.CreateInt64(n), nil
+
+	case DuckDBTypeUBigint:
+		n, err := convert.ToUint64(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert value to %s", typeID)
+		}
+		return db.CreateUint64(n), nil
+
+	case DuckDBTypeFloat, DuckDBTypeDouble:
+		f, err := convert.ToFloat64(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert value to %s", typeID)
+		}
+		return db.CreateDouble(f), nil
+
+	case DuckDBTypeTimestamp:
+		t, err := convert.ToTimestamp(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert value to %s", typeID)
+		}
+		return db.CreateTimestamp(timestampUnits(t, typeID)), nil
+
+	default:
+		text, err := db.valueText(value, logicalType, typeID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert value to %s", typeID)
+		}
+		return db.CreateVarchar(text), nil
+	}
+}
+
+// valueText converts a value to the text DuckDB casts to the given type
+func (db *DB) valueText(value any, logicalType DuckDBLogicalType, typeID DuckDBType) (string, error) {
+	switch typeID {
+	case DuckDBTypeVarchar:
+		return convert.ToString(value)
+	case DuckDBTypeBlob:
+		b, err := toBlob(value)
+		if err != nil {
+			return "", err
+		}
+		return formatBlob(b), nil
+	case DuckDBTypeDate:
+		d, err := convert.ToDate(value)
+		if err != nil {
+			return "", err
+		}
+		return formatTimestamp(d.ToTime(), "2006-01-02"), nil
+	case DuckDBTypeTime:
+		t, err := convert.ToTime(value)
+		if err != nil {
+			return "", err
+		}
+		return t.ToTime().Format("15:04:05.999999"), nil
+	case DuckDBTypeTimestamp:
+		t, err := convert.ToTimestamp(value)
+		if err != nil {
+			return "", err
+		}
+		return formatTimestamp(t.UTC(), "2006-01-02 15:04:05.999999"), nil
+	case DuckDBTypeTimestampS, DuckDBTypeTimestampMS, DuckDBTypeTimestampNS, DuckDBTypeTimestampTZ, DuckDBTypeTimeTZ:
+		return temporalText(value, typeID)
+	case DuckDBTypeInterval:
+		iv, err := toInterval(value)
+		if err != nil {
+			return "", err
+		}
+		return iv.String(), nil
+	case DuckDBTypeUBigint, DuckDBTypeHugeint, DuckDBTypeUHugeint, DuckDBTypeVarInt:
+		n, err := toBigInt(value)
+		if err != nil {
+			return "", err
+		}
+		return n.String(), nil
+	case DuckDBTypeDecimal:
+		d, err := toDecimal(value)
+		if err != nil {
+			return "", err
+		}
+		return d.String(), nil
+	case DuckDBTypeUUID:
+		u, err := toUUID(value)
+		if err != nil {
+			return "", err
+		}
+		return u.String(), nil
+	case DuckDBTypeBit:
+		b, err := toBitString(value)
+		if err != nil {
+			return "", err
+		}
+		return b.String(), nil
+	case DuckDBTypeEnum:
+		s, err := convert.ToString(value)
+		if err != nil {
+			return "", err
+		}
+		if _, err := enumValueIndex(enumDictionary(db, logicalType), s); err != nil {
+			return "", err
+		}
+		return s, nil
+	default:
+		return "", errors.Errorf("unsupported type %s", typeID)
+	}
+}
+
+// basicValue returns the value of v, with values of named bool, integer, float and string
+// types converted to bool, int64, uint64, float64 and string like database/sql does
+func basicValue(v reflect.Value) any {
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return v.String()
+	default:
+		return v.Interface()
+	}
+}
+
+// IsNestedValue reports whether value is a Go slice, array, map or struct,
+// or a pointer to one, that the driver binds as a LIST, ARRAY, MAP or STRUCT value.
+// Byte slices, times, unions and driver.Valuer implementations are not.
+func IsNestedValue(value any) bool {
+	if _, ok := value.(driver.Valuer); ok {
+		return false
+	}
+	t := reflect.TypeOf(value)
+	if t == bigIntType {
+		return false
+	}
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil {
+		return false
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		return t.Elem().Kind() != reflect.Uint8
+	case reflect.Map:
+		return true
+	case reflect.Struct:
+		return t != reflect.TypeOf(time.Time{}) && t != reflect.TypeOf(Union{})
+	default:
+		return false
+	}
+}
+
+// valueType returns the logical type of value, or fallback for NULL values if it is not nil.
+// The type belongs to the value or the fallback and must not be destroyed.
+func (db *DB) valueType(value DuckDBValue, fallback DuckDBLogicalType) DuckDBLogicalType {
+	if fallback != nil && db.IsNullValue(value) {
+		return fallback
+	}
+	return db.GetValueType(value)
+}
+
+// destroyValues destroys the values that were created
+func (db *DB) destroyValues(values []DuckDBValue) {
+	for i := range values {
+		if values[i] != nil {
+			db.DestroyValue(&values[i])
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package duckdb
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestIsNestedValue(t *testing.T) {
+	type point struct{ X, Y int }
+	var nilList *[]int
+
+	tests := []struct {
+		name     string
+		value    any
+		expected bool
+	}{
+		{"nil", nil, false},
+		{"slice", []string{"a"}, true},
+		{"array", [2]float32{1, 2}, true},
+		{"nested slice", [][]int{{1}}, true},
+		{"map", map[string]int{"a": 1}, true},
+		{"map entries", []MapEntry{{Key: 1, Value: 2}}, true},
+		{"struct", point{X: 1}, true},
+		{"pointer to struct", &point{}, true},
+		{"nil pointer to slice", nilList, true},
+		{"bytes", []byte{1}, false},
+		{"raw UUID", [16]byte{}, false},
+		{"time", time.Now(), false},
+		{"big integer", big.NewInt(1), false},
+		{"union", Union{Tag: "a", Value: 1}, false},
+		{"valuer", Interval{Days: 1}, false},
+		{"string", "text", false},
+		{"integer", 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNestedValue(tt.value); got != tt.expected {
+				t.Errorf("IsNestedValue(%#v) = %v, want %v", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
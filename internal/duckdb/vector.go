@@ -0,0 +1,493 @@
+package duckdb
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+	"unsafe"
+
+	"github.com/fpt/go-pduckdb/internal/convert"
+	"github.com/pkg/errors"
+)
+
+// ValueReader decodes the values of one logical type from DuckDB vectors.
+// Readers of nested types hold a reader for each child type.
+type ValueReader struct {
+	db          *DB
+	logicalType DuckDBLogicalType
+	typeID      DuckDBType
+	alias       string
+
+	// DECIMAL
+	width uint8
+	scale uint8
+	// ENUM
+	enumType DuckDBType
+	enumDict []string
+	// TIMESTAMP WITH TIME ZONE
+	location *time.Location
+	// ARRAY
+	arraySize int
+	// LIST, ARRAY, MAP (key and value), STRUCT and UNION children
+	children   []*ValueReader
+	childNames []string
+}
+
+// NewValueReader creates a reader for the logical type and takes ownership of it
+func NewValueReader(db *DB, logicalType DuckDBLogicalType) *ValueReader {
+	vr := &ValueReader{
+		db:          db,
+		logicalType: logicalType,
+		typeID:      db.GetTypeID(logicalType),
+	}
+	if db.LogicalTypeGetAlias != nil {
+		vr.alias = db.takeString(db.LogicalTypeGetAlias(logicalType))
+	}
+
+	switch vr.typeID {
+	case DuckDBTypeDecimal:
+		vr.width = db.DecimalWidth(logicalType)
+		vr.scale = db.DecimalScale(logicalType)
+	case DuckDBTypeEnum:
+		vr.enumType = db.EnumInternalType(logicalType)
+		vr.enumDict = enumDictionary(db, logicalType)
+	case DuckDBTypeList:
+		vr.children = []*ValueReader{NewValueReader(db, db.ListTypeChildType(logicalType))}
+	case DuckDBTypeArray:
+		vr.arraySize = int(db.ArrayTypeArraySize(logicalType))
+		vr.children = []*ValueReader{NewValueReader(db, db.ArrayTypeChildType(logicalType))}
+	case DuckDBTypeMap:
+		vr.children = []*ValueReader{
+			NewValueReader(db, db.MapTypeKeyType(logicalType)),
+			NewValueReader(db, db.MapTypeValueType(logicalType)),
+		}
+	case DuckDBTypeStruct:
+		count := db.StructTypeChildCount(logicalType)
+		for i := int64(0); i < count; i++ {
+			vr.childNames = append(vr.childNames, db.takeString(db.StructTypeChildName(logicalType, i)))
+			vr.children = append(vr.children, NewValueReader(db, db.StructTypeChildType(logicalType, i)))
+		}
+	case DuckDBTypeUnion:
+		count := db.UnionTypeMemberCount(logicalType)
+		for i := int64(0); i < count; i++ {
+			vr.childNames = append(vr.childNames, db.takeString(db.UnionTypeMemberName(logicalType, i)))
+			vr.children = append(vr.children, NewValueReader(db, db.UnionTypeMemberType(logicalType, i)))
+		}
+	}
+
+	return vr
+}
+
+// TypeID returns the DuckDB type decoded by the reader
+func (vr *ValueReader) TypeID() DuckDBType {
+	return vr.typeID
+}
+
+// Alias returns the alias of the logical type, such as "JSON"
+func (vr *ValueReader) Alias() string {
+	return vr.alias
+}
+
+// GoType returns the Go type of the values decoded by the reader.
+// Lists and arrays are decoded into slices of their element type, and maps into Go maps
+// of their key and value types when the keys can be Go map keys.
+func (vr *ValueReader) GoType() reflect.Type {
+	switch {
+	case vr.typeID == DuckDBTypeList || vr.typeID == DuckDBTypeArray:
+		return reflect.SliceOf(vr.children[0].GoType())
+	case vr.typeID == DuckDBTypeMap:
+		keyType := vr.children[0].GoType()
+		if !mapKeyType(keyType) {
+			return reflect.TypeOf([]MapEntry{})
+		}
+		return reflect.MapOf(keyType, vr.children[1].GoType())
+	case vr.typeID == DuckDBTypeVarchar && vr.alias == "JSON":
+		return reflect.TypeOf([]byte{})
+	default:
+		return vr.typeID.GoType()
+	}
+}
+
+// Close destroys the logical types owned by the reader
+func (vr *ValueReader) Close() {
+	for _, child := range vr.children {
+		child.Close()
+	}
+	if vr.logicalType != nil {
+		vr.db.DestroyLogicalType(&vr.logicalType)
+		vr.logicalType = nil
+	}
+}
+
+// Vector is a DuckDB vector prepared for decoding with a ValueReader
+type Vector struct {
+	reader   *ValueReader
+	handle   DuckDBVector
+	data     unsafe.Pointer
+	validity *uint64
+	// Child vectors of nested types, in the order of the reader's children
+	children []*Vector
+	// UNION tags
+	tags unsafe.Pointer
+}
+
+// Vector prepares a vector of the reader's type for decoding
+func (vr *ValueReader) Vector(handle DuckDBVector) *Vector {
+	db := vr.db
+	v := &Vector{
+		reader:   vr,
+		handle:   handle,
+		data:     db.VectorGetData(handle),
+		validity: db.VectorGetValidity(handle),
+	}
+
+	switch vr.typeID {
+	case DuckDBTypeList:
+		v.children = []*Vector{vr.children[0].Vector(db.ListVectorGetChild(handle))}
+	case DuckDBTypeArray:
+		v.children = []*Vector{vr.children[0].Vector(db.ArrayVectorGetChild(handle))}
+	case DuckDBTypeMap:
+		// A MAP is a list of STRUCT(key, value) entries
+		entries := db.ListVectorGetChild(handle)
+		v.children = []*Vector{
+			vr.children[0].Vector(db.StructVectorGetChild(entries, 0)),
+			vr.children[1].Vector(db.StructVectorGetChild(entries, 1)),
+		}
+	case DuckDBTypeStruct:
+		for i, child := range vr.children {
+			v.children = append(v.children, child.Vector(db.StructVectorGetChild(handle, int64(i))))
+		}
+	case DuckDBTypeUnion:
+		// A UNION is a STRUCT whose first child holds the member tags
+		v.tags = db.VectorGetData(db.StructVectorGetChild(handle, 0))
+		for i, child := range vr.children {
+			v.children = append(v.children, child.Vector(db.StructVectorGetChild(handle, int64(i+1))))
+		}
+	}
+
+	return v
+}
+
+// IsNull reports whether the value at the given row is NULL
+func (v *Vector) IsNull(row int) bool {
+	if v.validity == nil {
+		// All values are valid
+		return false
+	}
+	entry := *(*uint64)(unsafe.Add(unsafe.Pointer(v.validity), row/64*8))
+	return entry&(1<<(uint(row)%64)) == 0
+}
+
+// Value decodes the value at the given row, returning nil for NULL
+func (v *Vector) Value(row int) any {
+	if v.IsNull(row) {
+		return nil
+	}
+
+	switch v.reader.typeID {
+	case DuckDBTypeBoolean:
+		return get[bool](v.data, row)
+	case DuckDBTypeTinyint:
+		return get[int8](v.data, row)
+	case DuckDBTypeSmallint:
+		return get[int16](v.data, row)
+	case DuckDBTypeInteger:
+		return get[int32](v.data, row)
+	case DuckDBTypeBigint:
+		return get[int64](v.data, row)
+	case DuckDBTypeUTinyint:
+		return get[uint8](v.data, row)
+	case DuckDBTypeUSmallint:
+		return get[uint16](v.data, row)
+	case DuckDBTypeUInteger:
+		return get[uint32](v.data, row)
+	case DuckDBTypeUBigint:
+		return get[uint64](v.data, row)
+	case DuckDBTypeFloat:
+		return get[float32](v.data, row)
+	case DuckDBTypeDouble:
+		return get[float64](v.data, row)
+	case DuckDBTypeDate:
+		return dateFromDays(get[int32](v.data, row))
+	case DuckDBTypeTime:
+		return timeFromMicros(get[int64](v.data, row))
+	case DuckDBTypeTimestamp:
+		return timestampFromMicros(get[int64](v.data, row))
+	case DuckDBTypeTimestampS, DuckDBTypeTimestampMS, DuckDBTypeTimestampNS:
+		return timestampFromUnits(get[int64](v.data, row), v.reader.typeID)
+	case DuckDBTypeTimestampTZ:
+		return v.reader.timestampTZ(get[int64](v.data, row))
+	case DuckDBTypeTimeTZ:
+		return timeTZFromBits(get[uint64](v.data, row))
+	case DuckDBTypeInterval:
+		return get[Interval](v.data, row)
+	case DuckDBTypeHugeint:
+		h := get[hugeint](v.data, row)
+		return hugeintToBig(h.lower, int64(h.upper))
+	case DuckDBTypeUHugeint:
+		h := get[hugeint](v.data, row)
+		return uhugeintToBig(h.lower, h.upper)
+	case DuckDBTypeVarInt:
+		return varintToBig(stringBytes(v.data, row))
+	case DuckDBTypeBit:
+		return bitStringFromStorage(stringBytes(v.data, row))
+	case DuckDBTypeUUID:
+		return uuidFromHugeint(get[hugeint](v.data, row))
+	case DuckDBTypeEnum:
+		return v.reader.enumDict[v.enumIndex(row)]
+	case DuckDBTypeDecimal:
+		return Decimal{Width: v.reader.width, Scale: v.reader.scale, Unscaled: v.decimalUnscaled(row)}
+	case DuckDBTypeVarchar:
+		b := stringBytes(v.data, row)
+		if v.reader.alias == "JSON" {
+			return bytes.Clone(b)
+		}
+		return string(b)
+	case DuckDBTypeBlob:
+		return bytes.Clone(stringBytes(v.data, row))
+	case DuckDBTypeList:
+		entry := get[listEntry](v.data, row)
+		return v.listValue(int(entry.offset), int(entry.length))
+	case DuckDBTypeArray:
+		return v.arrayValue(row)
+	case DuckDBTypeMap:
+		entry := get[listEntry](v.data, row)
+		return v.mapValue(int(entry.offset), int(entry.length))
+	case DuckDBTypeStruct:
+		return v.structValue(row)
+	case DuckDBTypeUnion:
+		return v.unionValue(row)
+	default:
+		// Other types are returned in their text representation
+		return v.text(row)
+	}
+}
+
+// SetNull marks the value at the given row as NULL
+func (v *Vector) SetNull(row int) {
+	if v.validity == nil {
+		// Allocate the validity mask, with all values valid
+		v.reader.db.VectorEnsureValidityWritable(v.handle)
+		v.validity = v.reader.db.VectorGetValidity(v.handle)
+	}
+	entry := (*uint64)(unsafe.Add(unsafe.Pointer(v.validity), row/64*8))
+	*entry &^= 1 << (uint(row) % 64)
+}
+
+// SetValue converts value to the vector's type and stores it at the given row.
+// A nil value sets the row to NULL.
+func (v *Vector) SetValue(row int, value any) error {
+	if value == nil {
+		v.SetNull(row)
+		return nil
+	}
+
+	var err error
+	switch v.reader.typeID {
+	case DuckDBTypeBoolean:
+		err = setConverted(v.data, row, value, convert.ToBoolean)
+	case DuckDBTypeTinyint:
+		err = setConverted(v.data, row, value, convert.ToInt8)
+	case DuckDBTypeSmallint:
+		err = setConverted(v.data, row, value, convert.ToInt16)
+	case DuckDBTypeInteger:
+		err = setConverted(v.data, row, value, convert.ToInt32)
+	case DuckDBTypeBigint:
+		err = setConverted(v.data, row, value, convert.ToInt64)
+	case DuckDBTypeUTinyint:
+		err = setConverted(v.data, row, value, convert.ToUint8)
+	case DuckDBTypeUSmallint:
+		err = setConverted(v.data, row, value, convert.ToUint16)
+	case DuckDBTypeUInteger:
+		err = setConverted(v.data, row, value, convert.ToUint32)
+	case DuckDBTypeUBigint:
+		err = setConverted(v.data, row, value, convert.ToUint64)
+	case DuckDBTypeFloat:
+		err = setConverted(v.data, row, value, convert.ToFloat32)
+	case DuckDBTypeDouble:
+		err = setConverted(v.data, row, value, convert.ToFloat64)
+	case DuckDBTypeDate:
+		var date convert.Date
+		if date, err = convert.ToDate(value); err == nil {
+			set(v.data, row, date.Days)
+		}
+	case DuckDBTypeTime:
+		var t convert.Time
+		if t, err = convert.ToTime(value); err == nil {
+			set(v.data, row, t.Micros)
+		}
+	case DuckDBTypeTimestamp:
+		var ts time.Time
+		if ts, err = convert.ToTimestamp(value); err == nil {
+			set(v.data, row, timestampUnits(ts, DuckDBTypeTimestamp))
+		}
+	case DuckDBTypeTimestampS, DuckDBTypeTimestampMS, DuckDBTypeTimestampNS, DuckDBTypeTimestampTZ, DuckDBTypeTimeTZ:
+		var ts time.Time
+		if ts, err = convert.ToTimestamp(value); err == nil {
+			set(v.data, row, timestampUnits(ts, v.reader.typeID))
+		}
+	case DuckDBTypeInterval:
+		err = setConverted(v.data, row, value, toInterval)
+	case DuckDBTypeHugeint, DuckDBTypeUHugeint:
+		var n *big.Int
+		if n, err = toBigInt(value); err == nil {
+			var h hugeint
+			if h, err = toHugeint(n, v.reader.typeID == DuckDBTypeUHugeint); err == nil {
+				set(v.data, row, h)
+			}
+		}
+	case DuckDBTypeVarInt:
+		var n *big.Int
+		if n, err = toBigInt(value); err == nil {
+			b := bigToVarint(n)
+			v.reader.db.VectorAssignStringElementLen(v.handle, int64(row), dataPointer(unsafe.SliceData(b)), int64(len(b)))
+		}
+	case DuckDBTypeBit:
+		var bs BitString
+		if bs, err = toBitString(value); err == nil {
+			b := bs.storage()
+			v.reader.db.VectorAssignStringElementLen(v.handle, int64(row), dataPointer(unsafe.SliceData(b)), int64(len(b)))
+		}
+	case DuckDBTypeEnum:
+		err = v.setEnum(row, value)
+	case DuckDBTypeUUID:
+		var u UUID
+		if u, err = toUUID(value); err == nil {
+			set(v.data, row, u.hugeint())
+		}
+	case DuckDBTypeDecimal:
+		err = v.setDecimal(row, value)
+	case DuckDBTypeBlob:
+		var b []byte
+		if b, err = toBlob(value); err == nil {
+			// DuckDB copies the blob into the vector
+			v.reader.db.VectorAssignStringElementLen(v.handle, int64(row), dataPointer(unsafe.SliceData(b)), int64(len(b)))
+		}
+	case DuckDBTypeVarchar:
+		var b []byte
+		switch s := value.(type) {
+		case []byte:
+			b = s
+		default:
+			var str string
+			if str, err = convert.ToString(value); err == nil {
+				b = []byte(str)
+			}
+		}
+		if err == nil {
+			// DuckDB copies the string into the vector
+			v.reader.db.VectorAssignStringElementLen(v.handle, int64(row), dataPointer(unsafe.SliceData(b)), int64(len(b)))
+		}
+	default:
+		return fmt.Errorf("setting values of type %s is not supported", v.reader.typeID)
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, "failed to convert value to %s", v.reader.typeID)
+	}
+	return nil
+}
+
+// setDecimal stores value as a DECIMAL of the vector's width and scale
+func (v *Vector) setDecimal(row int, value any) error {
+	d, err := toDecimal(value)
+	if err != nil {
+		return err
+	}
+	unscaled, err := d.rescale(v.reader.scale)
+	if err != nil {
+		return err
+	}
+	if len(new(big.Int).Abs(unscaled).String()) > int(v.reader.width) {
+		return errors.Errorf("decimal %s does not fit width %d", d, v.reader.width)
+	}
+
+	// The physical storage of a DECIMAL depends on its width
+	switch width := v.reader.width; {
+	case width <= 4:
+		set(v.data, row, int16(unscaled.Int64()))
+	case width <= 9:
+		set(v.data, row, int32(unscaled.Int64()))
+	case width <= 18:
+		set(v.data, row, unscaled.Int64())
+	default:
+		lower, upper := bigToHugeint(unscaled)
+		set(v.data, row, hugeint{lower: lower, upper: upper})
+	}
+	return nil
+}
+
+// setEnum stores the dictionary index of an ENUM value
+func (v *Vector) setEnum(row int, value any) error {
+	s, err := convert.ToString(value)
+	if err != nil {
+		return err
+	}
+	index, err := enumValueIndex(v.reader.enumDict, s)
+	if err != nil {
+		return err
+	}
+
+	// The physical storage of an ENUM depends on the size of its dictionary
+	switch v.reader.enumType {
+	case DuckDBTypeUTinyint:
+		set(v.data, row, uint8(index))
+	case DuckDBTypeUSmallint:
+		set(v.data, row, uint16(index))
+	default:
+		set(v.data, row, uint32(index))
+	}
+	return nil
+}
+
+// setConverted converts value with conv and stores it at the given row of vector data
+func setConverted[T any](data unsafe.Pointer, row int, value any, conv func(any) (T, error)) error {
+	converted, err := conv(value)
+	if err != nil {
+		return err
+	}
+	set(data, row, converted)
+	return nil
+}
+
+// set writes the fixed size element at the given row of vector data
+func set[T any](data unsafe.Pointer, row int, value T) {
+	*(*T)(unsafe.Add(data, uintptr(row)*unsafe.Sizeof(value))) = value
+}
+
+// get reads the fixed size element at the given row of vector data
+func get[T any](data unsafe.Pointer, row int) T {
+	var zero T
+	return *(*T)(unsafe.Add(data, uintptr(row)*unsafe.Sizeof(zero)))
+}
+
+// stringBytes returns the bytes of the duckdb_string_t at the given row.
+// The returned slice points into DuckDB memory and must be copied before the chunk is destroyed.
+func stringBytes(data unsafe.Pointer, row int) []byte {
+	// duckdb_string_t is 16 bytes: a uint32 length followed by either
+	// up to 12 inlined bytes, or a 4 byte prefix and a pointer to the data
+	p := unsafe.Add(data, row*16)
+	length := *(*uint32)(p)
+	if length == 0 {
+		return []byte{}
+	}
+	if length <= 12 {
+		return unsafe.Slice((*byte)(unsafe.Add(p, 4)), length)
+	}
+	ptr := *(*unsafe.Pointer)(unsafe.Add(p, 8))
+	return unsafe.Slice((*byte)(ptr), length)
+}
+
+// listEntry mirrors duckdb_list_entry
+type listEntry struct {
+	offset uint64
+	length uint64
+}
+
+// hugeint mirrors duckdb_hugeint and duckdb_uhugeint
+type hugeint struct {
+	lower uint64
+	upper uint64
+}
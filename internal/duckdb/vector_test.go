@@ -0,0 +1,244 @@
+package duckdb
+
+import (
+	"bytes"
+	"math/big"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+// testVector creates a vector of the given type over Go memory
+func testVector[T any](typeID DuckDBType, values []T, validity []uint64) *Vector {
+	v := &Vector{
+		reader: &ValueReader{typeID: typeID},
+		data:   unsafe.Pointer(&values[0]),
+	}
+	if validity != nil {
+		v.validity = &validity[0]
+	}
+	return v
+}
+
+func TestVectorValue(t *testing.T) {
+	ints := testVector(DuckDBTypeInteger, []int32{1, 0, -3}, []uint64{0b101})
+	if got := ints.Value(0); got != int32(1) {
+		t.Errorf("Value(0) = %v, want 1", got)
+	}
+	if got := ints.Value(1); got != nil {
+		t.Errorf("Value(1) = %v, want nil for NULL", got)
+	}
+	if got := ints.Value(2); got != int32(-3) {
+		t.Errorf("Value(2) = %v, want -3", got)
+	}
+
+	// Without a validity mask all values are valid, including zero values
+	dates := testVector(DuckDBTypeDate, []int32{0, 19358}, nil)
+	if got := dates.Value(0); got == nil {
+		t.Errorf("Value(0) = nil, want 1970-01-01")
+	}
+	if got := dates.text(1); got != "2023-01-01" {
+		t.Errorf("text(1) = %v, want 2023-01-01", got)
+	}
+}
+
+func TestVectorSetValue(t *testing.T) {
+	values := []int64{0, 0, 0}
+	validity := []uint64{^uint64(0)}
+	v := testVector(DuckDBTypeBigint, values, validity)
+
+	if err := v.SetValue(0, 42); err != nil {
+		t.Errorf("SetValue(0, 42) failed: %v", err)
+	}
+	if err := v.SetValue(1, nil); err != nil {
+		t.Errorf("SetValue(1, nil) failed: %v", err)
+	}
+	if err := v.SetValue(2, "not a number"); err == nil {
+		t.Errorf("SetValue(2, string) succeeded, want error")
+	}
+
+	if values[0] != 42 {
+		t.Errorf("values[0] = %d, want 42", values[0])
+	}
+	if !v.IsNull(1) || v.IsNull(0) {
+		t.Errorf("validity = %b, want row 1 NULL", validity[0])
+	}
+
+	dates := testVector(DuckDBTypeDate, []int32{0}, nil)
+	if err := dates.SetValue(0, "2023-01-01"); err != nil {
+		t.Errorf("SetValue(0, date) failed: %v", err)
+	}
+	if got := dates.text(0); got != "2023-01-01" {
+		t.Errorf("text(0) = %v, want 2023-01-01", got)
+	}
+}
+
+func TestVectorStrings(t *testing.T) {
+	long := []byte("a string longer than twelve bytes")
+
+	// duckdb_string_t: length, then inlined data or prefix and pointer
+	data := make([]uint64, 4)
+	*(*uint32)(unsafe.Pointer(&data[0])) = 5
+	copy((*[12]byte)(unsafe.Add(unsafe.Pointer(&data[0]), 4))[:], "hello")
+	*(*uint32)(unsafe.Pointer(&data[2])) = uint32(len(long))
+	*(*unsafe.Pointer)(unsafe.Pointer(&data[3])) = unsafe.Pointer(&long[0])
+
+	v := testVector(DuckDBTypeVarchar, data, nil)
+	if got := v.Value(0); got != "hello" {
+		t.Errorf("Value(0) = %q, want %q", got, "hello")
+	}
+	if got := v.Value(1); got != string(long) {
+		t.Errorf("Value(1) = %q, want %q", got, long)
+	}
+
+	v.reader.alias = "JSON"
+	if got, ok := v.Value(0).([]byte); !ok || string(got) != "hello" {
+		t.Errorf("Value(0) = %v, want JSON bytes", v.Value(0))
+	}
+	runtime.KeepAlive(long)
+}
+
+func TestVectorBlob(t *testing.T) {
+	// Blobs are read with their length, including zero bytes
+	data := make([]uint64, 2)
+	payload := []byte{0x08, 0x00, 0xff, 0x00}
+	*(*uint32)(unsafe.Pointer(&data[0])) = uint32(len(payload))
+	copy((*[12]byte)(unsafe.Add(unsafe.Pointer(&data[0]), 4))[:], payload)
+
+	v := testVector(DuckDBTypeBlob, data, nil)
+	got, ok := v.Value(0).([]byte)
+	if !ok || !bytes.Equal(got, payload) {
+		t.Fatalf("Value(0) = %v, want %v", v.Value(0), payload)
+	}
+
+	// The value is a copy that outlives the vector
+	got[0] = 0
+	if again := v.Value(0).([]byte); again[0] != 0x08 {
+		t.Errorf("Value(0) shares memory with the vector")
+	}
+}
+
+func TestToBlob(t *testing.T) {
+	tests := []struct {
+		value    any
+		expected []byte
+		wantErr  bool
+	}{
+		{value: []byte{0, 1}, expected: []byte{0, 1}},
+		{value: "ab", expected: []byte("ab")},
+		{value: strings.NewReader("from a reader"), expected: []byte("from a reader")},
+		{value: 42, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := toBlob(tt.value)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("toBlob(%v) = %v, want error", tt.value, got)
+			}
+			continue
+		}
+		if err != nil || !bytes.Equal(got, tt.expected) {
+			t.Errorf("toBlob(%v) = %v, %v, want %v", tt.value, got, err, tt.expected)
+		}
+	}
+}
+
+func TestFormatDecimal(t *testing.T) {
+	tests := []struct {
+		unscaled int64
+		scale    int
+		expected string
+	}{
+		{12345, 2, "123.45"},
+		{-12345, 2, "-123.45"},
+		{5, 3, "0.005"},
+		{-5, 3, "-0.005"},
+		{42, 0, "42"},
+	}
+
+	for _, tt := range tests {
+		if got := formatDecimal(big.NewInt(tt.unscaled), tt.scale); got != tt.expected {
+			t.Errorf("formatDecimal(%d, %d) = %v, want %v", tt.unscaled, tt.scale, got, tt.expected)
+		}
+	}
+}
+
+func TestVarintToBig(t *testing.T) {
+	// 258 = 0x0102 in 2 bytes, positive header has the top bit set
+	positive := []byte{0x80, 0x00, 0x02, 0x01, 0x02}
+	if got := varintToBig(positive); got.Int64() != 258 {
+		t.Errorf("varintToBig() = %v, want 258", got)
+	}
+
+	negative := make([]byte, len(positive))
+	for i, b := range positive {
+		negative[i] = ^b
+	}
+	if got := varintToBig(negative); got.Int64() != -258 {
+		t.Errorf("varintToBig() = %v, want -258", got)
+	}
+}
+
+func TestSplitTimeTZ(t *testing.T) {
+	const maxOffset = 16*60*60 - 1
+	micros := int64(12 * 3_600_000_000)
+	bits := uint64(micros)<<24 | uint64(maxOffset-7200)
+
+	gotMicros, gotOffset := splitTimeTZ(bits)
+	if gotMicros != micros || gotOffset != 7200 {
+		t.Errorf("splitTimeTZ() = %d, %d, want %d, 7200", gotMicros, gotOffset, micros)
+	}
+	if got := formatOffset(gotOffset); got != "+02" {
+		t.Errorf("formatOffset() = %v, want +02", got)
+	}
+}
+
+func TestVectorTemporalValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		vector *Vector
+		want   []time.Time
+	}{
+		{
+			name:   "date",
+			vector: testVector(DuckDBTypeDate, []int32{0, -1, -25567}, []uint64{0b111}),
+			want: []time.Time{
+				time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:   "time",
+			vector: testVector(DuckDBTypeTime, []int64{0, 45_296_000_001, 86_399_999_999}, []uint64{0b111}),
+			want: []time.Time{
+				time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(1970, 1, 1, 12, 34, 56, 1000, time.UTC),
+				time.Date(1970, 1, 1, 23, 59, 59, 999_999_000, time.UTC),
+			},
+		},
+		{
+			name:   "timestamp",
+			vector: testVector(DuckDBTypeTimestamp, []int64{0, -1, -2_208_988_800_000_000}, []uint64{0b111}),
+			want: []time.Time{
+				time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(1969, 12, 31, 23, 59, 59, 999_999_000, time.UTC),
+				time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for row, want := range tt.want {
+				got, ok := tt.vector.Value(row).(time.Time)
+				if !ok || !got.Equal(want) {
+					t.Errorf("Value(%d) = %v, want %v", row, tt.vector.Value(row), want)
+				}
+			}
+		})
+	}
+}
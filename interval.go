@@ -0,0 +1,24 @@
+package pduckdb
+
+import (
+	"time"
+
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+)
+
+// Interval is a DuckDB INTERVAL of months, days and microseconds.
+// INTERVAL columns are read as Interval, which implements sql.Scanner.
+// INTERVAL parameters accept an Interval, a time.Duration or DuckDB's text representation.
+type Interval = duckdb.Interval
+
+// IntervalFromDuration converts a duration to an interval of microseconds.
+// Fractions of a microsecond are truncated.
+func IntervalFromDuration(d time.Duration) Interval {
+	return duckdb.IntervalFromDuration(d)
+}
+
+// ParseInterval parses an interval in DuckDB's text representation,
+// like "1 year 2 months 3 days 04:05:06"
+func ParseInterval(s string) (Interval, error) {
+	return duckdb.ParseInterval(s)
+}
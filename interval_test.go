@@ -0,0 +1,66 @@
+package pduckdb
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterval(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec("CREATE TABLE sla (id INTEGER, response INTERVAL)")
+	assert.NoError(t, err)
+
+	// Intervals, durations and text are bound to INTERVAL parameters
+	_, err = sqlDB.Exec("INSERT INTO sla VALUES (1, ?), (2, ?), (3, ?)",
+		Interval{Months: 1, Days: 2, Micros: 3_000_000}, 90*time.Minute, "-1 day 00:00:00.5")
+	assert.NoError(t, err)
+
+	var iv Interval
+	err = sqlDB.QueryRow("SELECT response FROM sla WHERE id = 1").Scan(&iv)
+	assert.NoError(t, err)
+	assert.Equal(t, Interval{Months: 1, Days: 2, Micros: 3_000_000}, iv)
+
+	err = sqlDB.QueryRow("SELECT response FROM sla WHERE id = 2").Scan(&iv)
+	assert.NoError(t, err)
+	assert.Equal(t, 90*time.Minute, iv.Duration())
+
+	var text string
+	err = sqlDB.QueryRow("SELECT response::VARCHAR FROM sla WHERE id = 3").Scan(&text)
+	assert.NoError(t, err)
+	assert.Equal(t, Interval{Days: -1, Micros: 500_000}.String(), text)
+
+	// Interval arithmetic with bound durations
+	var due time.Time
+	err = sqlDB.QueryRow("SELECT TIMESTAMP '2024-01-31 00:00:00' + ?", Interval{Months: 1}).Scan(&due)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), due)
+
+	// Go functions take and return durations
+	conn, err := sqlDB.Conn(t.Context())
+	assert.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+	err = conn.Raw(func(driverConn any) error {
+		return RegisterScalarFunction(driverConn, ScalarFunction{
+			Name:     "go_double",
+			Function: func(d time.Duration) time.Duration { return 2 * d },
+		})
+	})
+	assert.NoError(t, err)
+	err = conn.QueryRowContext(t.Context(), "SELECT go_double(response) FROM sla WHERE id = 2").Scan(&iv)
+	assert.NoError(t, err)
+	assert.Equal(t, 3*time.Hour, iv.Duration())
+}
@@ -0,0 +1,11 @@
+package pduckdb
+
+import (
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+)
+
+// List scans a DuckDB LIST into a slice of T. LIST columns are read as slices
+// of their element type, such as []int64 for BIGINT[] or []string for VARCHAR[],
+// and as []any when a list holds NULL elements. List converts the elements to T
+// like database/sql converts columns, so an INTEGER[] can be scanned into a List[int64].
+type List[T any] = duckdb.List[T]
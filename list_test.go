@@ -0,0 +1,145 @@
+package pduckdb
+
+import (
+	"database/sql"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec(`CREATE TABLE posts AS SELECT * FROM (VALUES
+		(1, 'go'), (1, 'sql'), (2, 'duckdb')) t(post_id, tag)`)
+	assert.NoError(t, err)
+
+	// Aggregated lists are read as slices of their element type
+	var tags []string
+	err = sqlDB.QueryRow("SELECT list(tag ORDER BY tag) FROM posts WHERE post_id = 1").Scan(&tags)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"go", "sql"}, tags)
+
+	var ids []int32
+	err = sqlDB.QueryRow("SELECT list(post_id ORDER BY post_id) FROM posts").Scan(&ids)
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{1, 1, 2}, ids)
+
+	var nested [][]int64
+	err = sqlDB.QueryRow("SELECT [[1, 2], [], [3]]::BIGINT[][]").Scan(&nested)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int64{{1, 2}, {}, {3}}, nested)
+
+	// Lists with NULL elements are read as []any
+	var withNull any
+	err = sqlDB.QueryRow("SELECT [1, NULL, 3]").Scan(&withNull)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{int32(1), nil, int32(3)}, withNull)
+
+	// List converts elements, and pointer elements receive NULL
+	var counts List[int64]
+	err = sqlDB.QueryRow("SELECT [1, 2]::INTEGER[]").Scan(&counts)
+	assert.NoError(t, err)
+	assert.Equal(t, List[int64]{1, 2}, counts)
+
+	var optional List[*int64]
+	err = sqlDB.QueryRow("SELECT [1, NULL]").Scan(&optional)
+	assert.NoError(t, err)
+	if assert.Len(t, optional, 2) {
+		assert.Equal(t, int64(1), *optional[0])
+		assert.Nil(t, optional[1])
+	}
+
+	err = sqlDB.QueryRow("SELECT NULL::INTEGER[]").Scan(&counts)
+	assert.NoError(t, err)
+	assert.Nil(t, counts)
+
+	// The scan type is the slice type
+	rows, err := sqlDB.Query("SELECT ['a']")
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, rows.Close())
+	}()
+	types, err := rows.ColumnTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, reflect.TypeOf([]string{}), types[0].ScanType())
+	assert.Equal(t, "VARCHAR[]", types[0].DatabaseTypeName())
+}
+
+func TestListParameters(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec("CREATE TABLE posts (id INTEGER, tags VARCHAR[], scores DOUBLE[][])")
+	assert.NoError(t, err)
+
+	// Slices bind to LIST columns, nested lists and NULL elements included
+	_, err = sqlDB.Exec("INSERT INTO posts VALUES (?, ?, ?), (?, ?, ?)",
+		1, []string{"go", "sql"}, [][]float64{{0.5}, {}},
+		2, []any{"duckdb", nil}, nil)
+	assert.NoError(t, err)
+
+	var tags []any
+	err = sqlDB.QueryRow("SELECT tags FROM posts WHERE id = 2").Scan(&tags)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{"duckdb", nil}, tags)
+
+	var scores [][]float64
+	err = sqlDB.QueryRow("SELECT scores FROM posts WHERE id = 1").Scan(&scores)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]float64{{0.5}, {}}, scores)
+
+	// A slice of ids filters rows, whether DuckDB knows the parameter type or not
+	var count int
+	err = sqlDB.QueryRow("SELECT count(*) FROM posts WHERE id IN (SELECT unnest(?))", []int{1, 2, 3}).Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	err = sqlDB.QueryRow("SELECT count(*) FROM posts WHERE list_contains(?::INTEGER[], id)", []int32{2}).Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	err = sqlDB.QueryRow("SELECT count(*) FROM posts WHERE id IN (SELECT unnest(?))", []int{}).Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// Elements are converted to the element type
+	_, err = sqlDB.Exec("INSERT INTO posts (id, tags) VALUES (3, ?)", []int{7})
+	assert.NoError(t, err)
+	err = sqlDB.QueryRow("SELECT tags FROM posts WHERE id = 3").Scan(&tags)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{"7"}, tags)
+
+	// Unsigned values beyond the BIGINT range keep their value
+	var largest uint64
+	err = sqlDB.QueryRow("SELECT list_max(?)", []uint64{1, math.MaxUint64}).Scan(&largest)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), largest)
+
+	// Times in nested parameters are TIMESTAMP values, not text
+	day := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	var typeName string
+	var first time.Time
+	err = sqlDB.QueryRow("SELECT typeof(?), (?)[1]", []time.Time{day}, []time.Time{day}).Scan(&typeName, &first)
+	assert.NoError(t, err)
+	assert.Equal(t, "TIMESTAMP[]", typeName)
+	assert.Equal(t, day, first.UTC())
+}
@@ -0,0 +1,15 @@
+package pduckdb
+
+import (
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+)
+
+// MapEntry is a key and value of a DuckDB MAP. MAP columns are read as Go maps
+// of their key and value types, such as map[string]float64 for MAP(VARCHAR, DOUBLE).
+// When the keys cannot be Go map keys, like BLOB or LIST keys, they are read
+// as a []MapEntry in the order of the MAP.
+type MapEntry = duckdb.MapEntry
+
+// Map scans a DuckDB MAP into a Go map. Keys and values convert to K and V
+// like database/sql converts columns, and pointer values receive NULL.
+type Map[K comparable, V any] = duckdb.Map[K, V]
@@ -0,0 +1,114 @@
+package pduckdb
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec(`CREATE TABLE metrics (
+		flags MAP(VARCHAR, DOUBLE), codes MAP(INTEGER, VARCHAR), daily MAP(DATE, BIGINT), raw MAP(BLOB, INTEGER))`)
+	assert.NoError(t, err)
+	_, err = sqlDB.Exec(`INSERT INTO metrics VALUES (
+		MAP {'beta': 0.5, 'dark_mode': 1.0},
+		MAP {404: 'not found', 500: NULL},
+		MAP {DATE '2024-01-01': 10},
+		MAP {'\x01'::BLOB: 1, 'a'::BLOB: 2})`)
+	assert.NoError(t, err)
+
+	// Maps are read as Go maps of their key and value types
+	var flags map[string]float64
+	err = sqlDB.QueryRow("SELECT flags FROM metrics").Scan(&flags)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float64{"beta": 0.5, "dark_mode": 1.0}, flags)
+
+	var daily map[time.Time]int64
+	err = sqlDB.QueryRow("SELECT daily FROM metrics").Scan(&daily)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), daily[time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)])
+
+	// Maps with NULL values are read with any values
+	var codes any
+	err = sqlDB.QueryRow("SELECT codes FROM metrics").Scan(&codes)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int32]any{404: "not found", 500: nil}, codes)
+
+	// Map converts keys and values, and pointer values receive NULL
+	var messages Map[int, *string]
+	err = sqlDB.QueryRow("SELECT codes FROM metrics").Scan(&messages)
+	assert.NoError(t, err)
+	if assert.Len(t, messages, 2) {
+		assert.Equal(t, "not found", *messages[404])
+		assert.Nil(t, messages[500])
+	}
+
+	// Keys that cannot be Go map keys are read as ordered entries
+	var raw []MapEntry
+	err = sqlDB.QueryRow("SELECT raw FROM metrics").Scan(&raw)
+	assert.NoError(t, err)
+	assert.Equal(t, []MapEntry{{Key: []byte{1}, Value: int32(1)}, {Key: []byte("a"), Value: int32(2)}}, raw)
+
+	// map_entries() keeps the order of any MAP
+	var entries List[MapEntry]
+	err = sqlDB.QueryRow("SELECT map_entries(flags) FROM metrics").Scan(&entries)
+	assert.NoError(t, err)
+	assert.Equal(t, List[MapEntry]{{Key: "beta", Value: 0.5}, {Key: "dark_mode", Value: 1.0}}, entries)
+
+	rows, err := sqlDB.Query("SELECT flags, raw FROM metrics")
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, rows.Close())
+	}()
+	types, err := rows.ColumnTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, reflect.TypeOf(map[string]float64{}), types[0].ScanType())
+	assert.Equal(t, reflect.TypeOf([]MapEntry{}), types[1].ScanType())
+	assert.Equal(t, "MAP(VARCHAR, DOUBLE)", types[0].DatabaseTypeName())
+}
+
+func TestMapParameters(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec("CREATE TABLE metrics (id INTEGER, flags MAP(VARCHAR, DOUBLE), daily MAP(DATE, BIGINT))")
+	assert.NoError(t, err)
+
+	// Go maps and []MapEntry bind to MAP columns, converting keys and values
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = sqlDB.Exec("INSERT INTO metrics VALUES (?, ?, ?)",
+		1, map[string]float64{"beta": 0.5}, []MapEntry{{Key: day, Value: 10}})
+	assert.NoError(t, err)
+
+	var flags map[string]float64
+	var daily map[time.Time]int64
+	err = sqlDB.QueryRow("SELECT flags, daily FROM metrics WHERE id = 1").Scan(&flags, &daily)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float64{"beta": 0.5}, flags)
+	assert.Equal(t, map[time.Time]int64{day: 10}, daily)
+
+	var beta float64
+	err = sqlDB.QueryRow("SELECT flags['beta'] FROM metrics WHERE flags = ?", map[string]float64{"beta": 0.5}).Scan(&beta)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.5, beta)
+}
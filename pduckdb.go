@@ -2,23 +2,42 @@
 package pduckdb
 
 import (
+	"time"
+
 	"github.com/fpt/go-pduckdb/internal/duckdb"
 )
 
 // DuckDB represents a DuckDB database instance
 type DuckDB struct {
 	db *duckdb.DB
+	// location overrides the session time zone of TIMESTAMP WITH TIME ZONE results
+	location *time.Location
+}
+
+// NewDuckDB creates a new DuckDB instance.
+// The dsn is the database path, optionally followed by configuration
+// options as accepted by ParseDSN, like "file.db?access_mode=read_only".
+func NewDuckDB(dsn string) (*DuckDB, error) {
+	path, config, err := ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDuckDBWithConfig(path, config)
 }
 
-// NewDuckDB creates a new DuckDB instance
-func NewDuckDB(path string) (*DuckDB, error) {
-	db, err := duckdb.NewDB(path)
+// NewDuckDBWithConfig creates a new DuckDB instance for the database at path,
+// opened with the given configuration.
+// Errors opening the database, like an invalid option, are returned as *Error.
+func NewDuckDBWithConfig(path string, config Config) (*DuckDB, error) {
+	db, err := duckdb.NewDB(path, config.options())
 	if err != nil {
 		return nil, err
 	}
 
 	return &DuckDB{
-		db: db,
+		db:       db,
+		location: config.TimeZone,
 	}, nil
 }
 
@@ -29,6 +48,10 @@ func (d *DuckDB) Connect() (*duckdb.Connection, error) {
 		return nil, ErrDuckDB{Message: "Failed to connect to database"}
 	}
 
+	// Without a configured location, TIMESTAMP WITH TIME ZONE values are returned
+	// in the session's TimeZone, which is read before a query that returns rows
+	conn.SetLocation(d.location)
+
 	return conn, nil
 }
 
@@ -0,0 +1,291 @@
+package pduckdb
+
+import (
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+)
+
+// ScalarFunction describes a Go function that can be called from SQL
+type ScalarFunction struct {
+	// Name is the SQL name of the function
+	Name string
+	// Function is called once per row, with one argument per SQL argument.
+	// It returns the result, optionally followed by an error that aborts the query:
+	//
+	//	func(s string, n int64) (string, error)
+	//
+	// A variadic Go function accepts a variable number of SQL arguments.
+	// Pointer and interface parameters receive nil for NULL arguments; for other
+	// parameters a NULL argument makes the result NULL without calling Function.
+	// A nil pointer or interface result is returned as NULL.
+	// Function is called concurrently from DuckDB's worker threads.
+	Function any
+	// Parameters declares the SQL types of the fixed parameters.
+	// If nil, they are derived from the Go parameter types.
+	Parameters []Type
+	// Varargs declares the SQL type of the variadic arguments.
+	// If TypeInvalid, it is derived from the Go parameter type.
+	Varargs Type
+	// Result declares the SQL result type.
+	// If TypeInvalid, it is derived from the Go result type.
+	Result Type
+	// Volatile marks functions that may return different results for the same
+	// arguments, such as random generators, so that calls are never folded
+	Volatile bool
+}
+
+// RegisterScalarFunction registers a Go function that can be called from SQL.
+// The function is visible to every connection of the database.
+// The driver connection is obtained from a *sql.Conn with Raw:
+//
+//	err := conn.Raw(func(driverConn any) error {
+//		return pduckdb.RegisterScalarFunction(driverConn, pduckdb.ScalarFunction{
+//			Name:     "reverse_words",
+//			Function: reverseWords,
+//		})
+//	})
+func RegisterScalarFunction(driverConn any, f ScalarFunction) error {
+	conn, ok := driverConn.(*Conn)
+	if !ok {
+		return errors.Errorf("scalar functions require a pduckdb connection, got %T", driverConn)
+	}
+
+	sf, err := newScalarFunction(f)
+	if err != nil {
+		return err
+	}
+
+	conn.bufferRows()
+	return conn.conn.RegisterScalarFunction(sf)
+}
+
+// newScalarFunction derives the SQL signature of a Go function and
+// wraps it to be called with the argument values of one row
+func newScalarFunction(f ScalarFunction) (*duckdb.ScalarFunction, error) {
+	fn := reflect.ValueOf(f.Function)
+	if fn.Kind() != reflect.Func {
+		return nil, errors.Errorf("function %s must be a Go function, got %T", f.Name, f.Function)
+	}
+	ft := fn.Type()
+
+	switch {
+	case ft.NumOut() == 1:
+	case ft.NumOut() == 2 && ft.Out(1) == errorType:
+	default:
+		return nil, errors.Errorf("function %s must return a value, optionally followed by an error", f.Name)
+	}
+
+	// Go types of the arguments, with the element type for variadic arguments
+	argTypes := make([]reflect.Type, ft.NumIn())
+	for i := range argTypes {
+		argTypes[i] = ft.In(i)
+	}
+	fixed := len(argTypes)
+	if ft.IsVariadic() {
+		fixed--
+		argTypes[fixed] = argTypes[fixed].Elem()
+	}
+
+	sf := &duckdb.ScalarFunction{
+		Name:       f.Name,
+		Parameters: f.Parameters,
+		Varargs:    f.Varargs,
+		Result:     f.Result,
+		Volatile:   f.Volatile,
+	}
+
+	if sf.Parameters == nil {
+		sf.Parameters = make([]Type, fixed)
+		for i := range sf.Parameters {
+			t, err := typeOf(argTypes[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "parameter %d of function %s", i+1, f.Name)
+			}
+			sf.Parameters[i] = t
+		}
+	} else if len(sf.Parameters) != fixed {
+		return nil, errors.Errorf("function %s declares %d parameters, but takes %d", f.Name, len(sf.Parameters), fixed)
+	}
+
+	if ft.IsVariadic() && sf.Varargs == TypeInvalid {
+		t, err := typeOf(argTypes[fixed])
+		if err != nil {
+			return nil, errors.Wrapf(err, "variadic parameter of function %s", f.Name)
+		}
+		sf.Varargs = t
+	}
+
+	if sf.Result == TypeInvalid {
+		t, err := typeOf(ft.Out(0))
+		if err != nil {
+			return nil, errors.Wrapf(err, "result of function %s", f.Name)
+		}
+		sf.Result = t
+	}
+
+	// Functions that accept NULL must also see constant NULL arguments
+	for _, t := range argTypes {
+		if nullable(t) {
+			sf.SpecialHandling = true
+		}
+	}
+
+	sf.Function = func(args []any) (any, error) {
+		in := make([]reflect.Value, 0, len(args))
+		for i, arg := range args {
+			t := argTypes[min(i, len(argTypes)-1)]
+			if arg == nil && !nullable(t) {
+				// NULL in, NULL out
+				return nil, nil
+			}
+			v, err := argValue(arg, t)
+			if err != nil {
+				return nil, errors.Wrapf(err, "argument %d of function %s", i+1, f.Name)
+			}
+			in = append(in, v)
+		}
+
+		out := fn.Call(in)
+		if len(out) == 2 && !out[1].IsNil() {
+			return nil, out[1].Interface().(error)
+		}
+		return resultValue(out[0]), nil
+	}
+
+	return sf, nil
+}
+
+var (
+	errorType    = reflect.TypeOf((*error)(nil)).Elem()
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	intervalType = reflect.TypeOf(Interval{})
+	bigIntType   = reflect.TypeOf((*big.Int)(nil))
+	hugeIntType  = reflect.TypeOf(HugeInt{})
+	bytesType    = reflect.TypeOf([]byte(nil))
+	uuidType     = reflect.TypeOf(UUID{})
+	bitsType     = reflect.TypeOf(BitString{})
+)
+
+// typeOf returns the DuckDB type of a Go type
+func typeOf(t reflect.Type) (Type, error) {
+	if t == bigIntType {
+		return TypeHugeint, nil
+	}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t {
+	case timeType:
+		return TypeTimestamp, nil
+	case durationType, intervalType:
+		return TypeInterval, nil
+	case hugeIntType:
+		return TypeHugeint, nil
+	case bytesType:
+		return TypeBlob, nil
+	case uuidType:
+		return TypeUUID, nil
+	case bitsType:
+		return TypeBit, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return TypeBoolean, nil
+	case reflect.Int8:
+		return TypeTinyint, nil
+	case reflect.Int16:
+		return TypeSmallint, nil
+	case reflect.Int32:
+		return TypeInteger, nil
+	case reflect.Int, reflect.Int64:
+		return TypeBigint, nil
+	case reflect.Uint8:
+		return TypeUTinyint, nil
+	case reflect.Uint16:
+		return TypeUSmallint, nil
+	case reflect.Uint32:
+		return TypeUInteger, nil
+	case reflect.Uint, reflect.Uint64:
+		return TypeUBigint, nil
+	case reflect.Float32:
+		return TypeFloat, nil
+	case reflect.Float64:
+		return TypeDouble, nil
+	case reflect.String:
+		return TypeVarchar, nil
+	default:
+		return TypeInvalid, errors.Errorf("no DuckDB type for Go type %s, declare it explicitly", t)
+	}
+}
+
+// nullable reports whether a Go parameter of type t can receive NULL as nil
+func nullable(t reflect.Type) bool {
+	return t.Kind() == reflect.Pointer || t.Kind() == reflect.Interface
+}
+
+// argValue converts a value read from DuckDB to the Go parameter type t
+func argValue(arg any, t reflect.Type) (reflect.Value, error) {
+	if arg == nil {
+		return reflect.Zero(t), nil
+	}
+
+	target := t
+	if t.Kind() == reflect.Pointer {
+		target = t.Elem()
+	}
+
+	if iv, ok := arg.(Interval); ok && target == durationType {
+		arg = iv.Duration()
+	}
+	if n, ok := arg.(*big.Int); ok {
+		if t == bigIntType {
+			return reflect.ValueOf(n), nil
+		}
+		if target == hugeIntType {
+			arg = HugeInt{Int: n}
+		}
+	}
+
+	v := reflect.ValueOf(arg)
+	switch {
+	case v.Type().AssignableTo(target):
+	case v.Type().ConvertibleTo(target) && (v.Kind() == reflect.String) == (target.Kind() == reflect.String):
+		// Numeric conversions, but not integers to strings of one rune
+		v = v.Convert(target)
+	default:
+		return reflect.Value{}, errors.Errorf("cannot convert %T to %s", arg, t)
+	}
+
+	if t.Kind() == reflect.Pointer {
+		p := reflect.New(target)
+		p.Elem().Set(v)
+		return p, nil
+	}
+	return v, nil
+}
+
+// resultValue returns the value of a Go result, with nil for nil pointers and interfaces
+func resultValue(v reflect.Value) any {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Type() == bigIntType {
+			return v.Interface()
+		}
+		return v.Elem().Interface()
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+	}
+	return v.Interface()
+}
@@ -0,0 +1,285 @@
+package pduckdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewScalarFunctionSignature(t *testing.T) {
+	tests := []struct {
+		name       string
+		function   ScalarFunction
+		parameters []Type
+		varargs    Type
+		result     Type
+		special    bool
+		wantErr    bool
+	}{
+		{
+			name:       "derived from Go types",
+			function:   ScalarFunction{Function: func(s string, n int64, f float32) (bool, error) { return true, nil }},
+			parameters: []Type{TypeVarchar, TypeBigint, TypeFloat},
+			result:     TypeBoolean,
+		},
+		{
+			name:       "durations",
+			function:   ScalarFunction{Function: func(d time.Duration, iv Interval) time.Duration { return d }},
+			parameters: []Type{TypeInterval, TypeInterval},
+			result:     TypeInterval,
+		},
+		{
+			name:       "big integers",
+			function:   ScalarFunction{Function: func(n *big.Int, h HugeInt) *big.Int { return n }},
+			parameters: []Type{TypeHugeint, TypeHugeint},
+			result:     TypeHugeint,
+			special:    true,
+		},
+		{
+			name:       "blobs",
+			function:   ScalarFunction{Function: func(b []byte) []byte { return b }},
+			parameters: []Type{TypeBlob},
+			result:     TypeBlob,
+		},
+		{
+			name:       "uuids",
+			function:   ScalarFunction{Function: func(u UUID) string { return u.String() }},
+			parameters: []Type{TypeUUID},
+			result:     TypeVarchar,
+		},
+		{
+			name:       "bit strings",
+			function:   ScalarFunction{Function: func(b BitString) int { return b.Count() }},
+			parameters: []Type{TypeBit},
+			result:     TypeBigint,
+		},
+		{
+			name:       "variadic",
+			function:   ScalarFunction{Function: func(sep string, parts ...string) string { return "" }},
+			parameters: []Type{TypeVarchar},
+			varargs:    TypeVarchar,
+			result:     TypeVarchar,
+		},
+		{
+			name:       "nullable parameter",
+			function:   ScalarFunction{Function: func(n *int32) int32 { return 0 }},
+			parameters: []Type{TypeInteger},
+			result:     TypeInteger,
+			special:    true,
+		},
+		{
+			name: "declared types",
+			function: ScalarFunction{
+				Function:   func(v any) string { return "" },
+				Parameters: []Type{TypeAny},
+				Result:     TypeVarchar,
+			},
+			parameters: []Type{TypeAny},
+			result:     TypeVarchar,
+			special:    true,
+		},
+		{
+			name:     "undeclared interface parameter",
+			function: ScalarFunction{Function: func(v any) string { return "" }},
+			wantErr:  true,
+		},
+		{
+			name: "wrong number of declared parameters",
+			function: ScalarFunction{
+				Function:   func(a, b int64) int64 { return 0 },
+				Parameters: []Type{TypeBigint},
+			},
+			wantErr: true,
+		},
+		{
+			name:     "no result",
+			function: ScalarFunction{Function: func(a int64) {}},
+			wantErr:  true,
+		},
+		{
+			name:     "second result is not an error",
+			function: ScalarFunction{Function: func(a int64) (int64, int64) { return 0, 0 }},
+			wantErr:  true,
+		},
+		{
+			name:     "not a function",
+			function: ScalarFunction{Function: 42},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sf, err := newScalarFunction(tt.function)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.parameters, sf.Parameters)
+			assert.Equal(t, tt.varargs, sf.Varargs)
+			assert.Equal(t, tt.result, sf.Result)
+			assert.Equal(t, tt.special, sf.SpecialHandling)
+		})
+	}
+}
+
+func TestNewScalarFunctionCall(t *testing.T) {
+	sf, err := newScalarFunction(ScalarFunction{
+		Function: func(sep string, n int, parts ...string) (string, error) {
+			if n < 0 {
+				return "", errors.New("negative count")
+			}
+			return strings.Repeat(strings.Join(parts, sep), n), nil
+		},
+	})
+	assert.NoError(t, err)
+
+	// Values are converted from the types read from DuckDB
+	result, err := sf.Function([]any{"-", int64(2), "a", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, "a-ba-b", result)
+
+	// NULL arguments produce NULL without calling the function
+	result, err = sf.Function([]any{"-", nil, "a"})
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+
+	_, err = sf.Function([]any{"-", int64(-1)})
+	assert.EqualError(t, err, "negative count")
+
+	_, err = sf.Function([]any{"-", "not a number"})
+	assert.Error(t, err)
+}
+
+func TestNewScalarFunctionDuration(t *testing.T) {
+	sf, err := newScalarFunction(ScalarFunction{
+		Function: func(d time.Duration) time.Duration { return 2 * d },
+	})
+	assert.NoError(t, err)
+
+	// INTERVAL arguments are converted to durations
+	result, err := sf.Function([]any{Interval{Days: 1, Micros: 1}})
+	assert.NoError(t, err)
+	assert.Equal(t, 48*time.Hour+2*time.Microsecond, result)
+}
+
+func TestNewScalarFunctionBigInt(t *testing.T) {
+	sf, err := newScalarFunction(ScalarFunction{
+		Function: func(n *big.Int, h HugeInt) *big.Int { return new(big.Int).Add(n, h.Int) },
+	})
+	assert.NoError(t, err)
+
+	result, err := sf.Function([]any{big.NewInt(40), big.NewInt(2)})
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(42), result)
+}
+
+func TestNewScalarFunctionNullable(t *testing.T) {
+	sf, err := newScalarFunction(ScalarFunction{
+		Function: func(n *int32) *string {
+			if n == nil {
+				s := "null"
+				return &s
+			}
+			if *n == 0 {
+				return nil
+			}
+			s := "value"
+			return &s
+		},
+	})
+	assert.NoError(t, err)
+
+	result, err := sf.Function([]any{nil})
+	assert.NoError(t, err)
+	assert.Equal(t, "null", result)
+
+	result, err = sf.Function([]any{int32(0)})
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+
+	result, err = sf.Function([]any{int32(1)})
+	assert.NoError(t, err)
+	assert.Equal(t, "value", result)
+}
+
+func TestScalarFunction(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	ctx := context.Background()
+	conn, err := sqlDB.Conn(ctx)
+	assert.NoError(t, err, "Error getting connection")
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	err = conn.Raw(func(driverConn any) error {
+		err := RegisterScalarFunction(driverConn, ScalarFunction{
+			Name: "go_repeat",
+			Function: func(s string, n int64) (string, error) {
+				if n < 0 {
+					return "", errors.New("negative count")
+				}
+				return strings.Repeat(s, int(n)), nil
+			},
+		})
+		if err != nil {
+			return err
+		}
+		return RegisterScalarFunction(driverConn, ScalarFunction{
+			Name: "go_coalesce_len",
+			Function: func(s *string) int32 {
+				if s == nil {
+					return -1
+				}
+				return int32(len(*s))
+			},
+		})
+	})
+	assert.NoError(t, err, "Error registering functions")
+
+	var s string
+	err = conn.QueryRowContext(ctx, "SELECT go_repeat('ab', 3)").Scan(&s)
+	assert.NoError(t, err)
+	assert.Equal(t, "ababab", s)
+
+	// Called for every row across several chunks
+	var total int64
+	err = conn.QueryRowContext(ctx, "SELECT sum(length(go_repeat('x', i % 5))) FROM range(10000) t(i)").Scan(&total)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20000), total)
+
+	var ns sql.NullString
+	err = conn.QueryRowContext(ctx, "SELECT go_repeat(NULL, 3)").Scan(&ns)
+	assert.NoError(t, err)
+	assert.False(t, ns.Valid)
+
+	var n int32
+	err = conn.QueryRowContext(ctx, "SELECT go_coalesce_len(NULL)").Scan(&n)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(-1), n)
+
+	// Errors of the Go function abort the query
+	err = conn.QueryRowContext(ctx, "SELECT go_repeat('x', -1)").Scan(&s)
+	assert.ErrorContains(t, err, "negative count")
+
+	// Functions are visible to other connections of the database
+	err = sqlDB.QueryRowContext(ctx, "SELECT go_repeat('y', 2)").Scan(&s)
+	assert.NoError(t, err)
+	assert.Equal(t, "yy", s)
+}
@@ -0,0 +1,59 @@
+package pduckdb
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+)
+
+// Queryer runs queries, like *sql.DB, *sql.Conn and *sql.Tx
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// TopKSimilar queries the k rows of table whose embedding column is most similar to
+// embedding by array_cosine_similarity, most similar first. The column must be a
+// FLOAT[N] for []float32 embeddings or a DOUBLE[N] for []float64 embeddings,
+// where N is the length of embedding. The rows hold the given columns, or all
+// columns of the table if none are given, followed by the similarity as a float.
+func TopKSimilar[T float32 | float64](ctx context.Context, q Queryer, table, column string, embedding []T, k int, columns ...string) (*sql.Rows, error) {
+	query := similarityQuery(table, column, arrayTypeName[T](len(embedding)), columns)
+	return q.QueryContext(ctx, query, embedding, k)
+}
+
+// similarityQuery builds the query of TopKSimilar.
+// The embedding and k are its parameters.
+func similarityQuery(table, column, arrayType string, columns []string) string {
+	selected := "*"
+	if len(columns) > 0 {
+		quoted := make([]string, len(columns))
+		for i, c := range columns {
+			quoted[i] = quoteIdentifier(c)
+		}
+		selected = strings.Join(quoted, ", ")
+	}
+
+	// Tables may be qualified by a schema, like main.documents
+	parts := strings.Split(table, ".")
+	for i, part := range parts {
+		parts[i] = quoteIdentifier(part)
+	}
+
+	return "SELECT " + selected + ", array_cosine_similarity(" + quoteIdentifier(column) + ", ?::" + arrayType + ") AS similarity" +
+		" FROM " + strings.Join(parts, ".") + " ORDER BY similarity DESC LIMIT ?"
+}
+
+// arrayTypeName returns the name of the ARRAY type of size elements of T
+func arrayTypeName[T float32 | float64](size int) string {
+	var zero T
+	if _, ok := any(zero).(float32); ok {
+		return "FLOAT[" + strconv.Itoa(size) + "]"
+	}
+	return "DOUBLE[" + strconv.Itoa(size) + "]"
+}
+
+// quoteIdentifier quotes a SQL identifier, doubling the quotes inside it
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,75 @@
+package pduckdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimilarityQuery(t *testing.T) {
+	assert.Equal(t,
+		`SELECT "id", "ti""tle", array_cosine_similarity("embedding", ?::FLOAT[3]) AS similarity FROM "main"."docs" ORDER BY similarity DESC LIMIT ?`,
+		similarityQuery("main.docs", "embedding", arrayTypeName[float32](3), []string{"id", `ti"tle`}))
+	assert.Equal(t,
+		`SELECT *, array_cosine_similarity("e", ?::DOUBLE[768]) AS similarity FROM "docs" ORDER BY similarity DESC LIMIT ?`,
+		similarityQuery("docs", "e", arrayTypeName[float64](768), nil))
+}
+
+func TestArray(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec("CREATE TABLE docs (id INTEGER, title VARCHAR, embedding FLOAT[3], exact DOUBLE[2], counts INTEGER[2])")
+	assert.NoError(t, err)
+
+	// Arrays are bound from slices and Go arrays of their size
+	_, err = sqlDB.Exec("INSERT INTO docs VALUES (1, 'north', ?, ?, ?), (2, 'east', ?, NULL, NULL), (3, 'up', ?, NULL, NULL)",
+		[]float32{0.1, 0.9, 0}, [2]float64{1.0 / 3, 2}, []int64{1, 2},
+		[]float32{1, 0, 0}, []float32{0, 0, 1})
+	assert.NoError(t, err)
+
+	_, err = sqlDB.Exec("INSERT INTO docs (id, embedding) VALUES (4, ?)", []float32{1, 2})
+	assert.ErrorContains(t, err, "ARRAY of size 3 cannot hold 2 values")
+
+	// Arrays are read as slices of their element type, without losing precision
+	var embedding []float32
+	var exact []float64
+	var counts []int32
+	err = sqlDB.QueryRow("SELECT embedding, exact, counts FROM docs WHERE id = 1").Scan(&embedding, &exact, &counts)
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{0.1, 0.9, 0}, embedding)
+	assert.Equal(t, []float64{1.0 / 3, 2}, exact)
+	assert.Equal(t, []int32{1, 2}, counts)
+
+	var withNull any
+	err = sqlDB.QueryRow("SELECT [1.5, NULL]::DOUBLE[2]").Scan(&withNull)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{1.5, nil}, withNull)
+
+	// The most similar rows come first
+	rows, err := TopKSimilar(context.Background(), sqlDB, "docs", "embedding", []float32{0.2, 1, 0}, 2, "id", "title")
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, rows.Close())
+	}()
+
+	var titles []string
+	for rows.Next() {
+		var id int
+		var title string
+		var similarity float64
+		assert.NoError(t, rows.Scan(&id, &title, &similarity))
+		titles = append(titles, title)
+	}
+	assert.NoError(t, rows.Err())
+	assert.Equal(t, []string{"north", "east"}, titles)
+}
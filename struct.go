@@ -0,0 +1,19 @@
+package pduckdb
+
+import (
+	"database/sql"
+
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+)
+
+// ScanStruct returns a sql.Scanner that fills the struct dest points to from a STRUCT column.
+// STRUCT columns are read as map[string]any by default. With ScanStruct, children are
+// matched to fields by their `duckdb:"name"` tag, or by the field name ignoring case,
+// and nested STRUCT and LIST children fill nested structs and slices.
+// A NULL child leaves its field at the zero value; use pointer fields to tell NULL apart.
+//
+//	var e Event
+//	err := db.QueryRow("SELECT payload FROM events").Scan(pduckdb.ScanStruct(&e))
+func ScanStruct(dest any) sql.Scanner {
+	return duckdb.ScanStruct(dest)
+}
@@ -0,0 +1,130 @@
+package pduckdb
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStruct(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec(`CREATE TABLE events AS SELECT * FROM (VALUES
+		(1, {'type': 'click', 'device': {'model': 'pixel', 'os': NULL}, 'tags': ['a', 'b']}),
+		(2, NULL)) t(id, payload)`)
+	assert.NoError(t, err)
+
+	// STRUCT values are read as maps of their children
+	var payload map[string]any
+	err = sqlDB.QueryRow("SELECT payload FROM events WHERE id = 1").Scan(&payload)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"type":   "click",
+		"device": map[string]any{"model": "pixel", "os": nil},
+		"tags":   []string{"a", "b"},
+	}, payload)
+
+	// ScanStruct fills tagged and matching fields, including nested structs and lists
+	type device struct {
+		Model string
+		OS    *string `duckdb:"os"`
+	}
+	type event struct {
+		Kind   string `duckdb:"type"`
+		Device device
+		Tags   []string
+	}
+
+	var e event
+	err = sqlDB.QueryRow("SELECT payload FROM events WHERE id = 1").Scan(ScanStruct(&e))
+	assert.NoError(t, err)
+	assert.Equal(t, event{Kind: "click", Device: device{Model: "pixel"}, Tags: []string{"a", "b"}}, e)
+
+	p := &event{}
+	err = sqlDB.QueryRow("SELECT payload FROM events WHERE id = 2").Scan(ScanStruct(&p))
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+
+	// Lists of structs are read as slices of maps, and fill slices of structs
+	var devices List[device]
+	err = sqlDB.QueryRow("SELECT [{'model': 'a', 'os': 'android'}, {'model': 'b', 'os': NULL}]").Scan(&devices)
+	assert.NoError(t, err)
+	if assert.Len(t, devices, 2) {
+		assert.Equal(t, "android", *devices[0].OS)
+		assert.Nil(t, devices[1].OS)
+	}
+
+	rows, err := sqlDB.Query("SELECT payload FROM events")
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, rows.Close())
+	}()
+	types, err := rows.ColumnTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, reflect.TypeOf(map[string]any{}), types[0].ScanType())
+	assert.Equal(t, "STRUCT(type VARCHAR, device STRUCT(model VARCHAR, os VARCHAR), tags VARCHAR[])", types[0].DatabaseTypeName())
+}
+
+func TestStructParameters(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec(`CREATE TABLE events (
+		id INTEGER, payload STRUCT(type VARCHAR, device STRUCT(model VARCHAR, os VARCHAR), tags VARCHAR[]))`)
+	assert.NoError(t, err)
+
+	type device struct {
+		Model string
+		OS    *string `duckdb:"os"`
+	}
+	type event struct {
+		Kind    string `duckdb:"type"`
+		Device  device
+		Tags    []string
+		Ignored int `duckdb:"-"`
+	}
+
+	// Structs bind by tag or field name, and maps by key; missing children are NULL
+	android := "android"
+	_, err = sqlDB.Exec("INSERT INTO events VALUES (?, ?), (?, ?)",
+		1, event{Kind: "click", Device: device{Model: "pixel", OS: &android}, Tags: []string{"a"}},
+		2, map[string]any{"type": "view"})
+	assert.NoError(t, err)
+
+	var e event
+	err = sqlDB.QueryRow("SELECT payload FROM events WHERE id = 1").Scan(ScanStruct(&e))
+	assert.NoError(t, err)
+	assert.Equal(t, "click", e.Kind)
+	assert.Equal(t, "pixel", e.Device.Model)
+	if assert.NotNil(t, e.Device.OS) {
+		assert.Equal(t, "android", *e.Device.OS)
+	}
+	assert.Equal(t, []string{"a"}, e.Tags)
+
+	var payload map[string]any
+	err = sqlDB.QueryRow("SELECT payload FROM events WHERE id = 2").Scan(&payload)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"type": "view", "device": nil, "tags": nil}, payload)
+
+	// Only the children of the parameter type are bound
+	err = sqlDB.QueryRow("SELECT ?::STRUCT(type VARCHAR)", event{Kind: "scroll"}).Scan(&payload)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"type": "scroll"}, payload)
+}
@@ -0,0 +1,50 @@
+package pduckdb
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+)
+
+// TableFunction describes a Go data source that can be queried like a table:
+//
+//	SELECT * FROM my_source(42, limit := 10)
+//
+// Bind is called when the query is planned. It receives the positional arguments
+// and the named arguments that were given, and returns a TableSource that
+// describes the columns and produces the rows.
+type TableFunction = duckdb.TableFunction
+
+// TableColumn is a column of the table produced by a table function
+type TableColumn = duckdb.TableColumn
+
+// TableSource describes the table produced by one table function call.
+// Its Scan function is called for every scan of the table, with the indexes of
+// the columns to produce; with ProjectionPushdown these are only the columns
+// used by the query.
+type TableSource = duckdb.TableSource
+
+// TableRows produces the rows of a table function scan, one vector of rows at a time.
+// If it implements io.Closer, it is closed when the scan ends.
+type TableRows = duckdb.TableRows
+
+// RegisterTableFunction registers a Go table function that can be queried from SQL.
+// The function is visible to every connection of the database.
+// The driver connection is obtained from a *sql.Conn with Raw:
+//
+//	err := conn.Raw(func(driverConn any) error {
+//		return pduckdb.RegisterTableFunction(driverConn, pduckdb.TableFunction{
+//			Name:       "events",
+//			Parameters: []pduckdb.Type{pduckdb.TypeBigint},
+//			Bind:       bindEvents,
+//		})
+//	})
+func RegisterTableFunction(driverConn any, f TableFunction) error {
+	conn, ok := driverConn.(*Conn)
+	if !ok {
+		return errors.Errorf("table functions require a pduckdb connection, got %T", driverConn)
+	}
+
+	conn.bufferRows()
+	return conn.conn.RegisterTableFunction(&f)
+}
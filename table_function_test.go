@@ -0,0 +1,127 @@
+package pduckdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rangeRows produces the rows i, i*10, "row i" for i in [0, n), limited to the scanned columns
+type rangeRows struct {
+	n, i    int64
+	columns []int
+	closed  *bool
+}
+
+func (r *rangeRows) Next(row []any) (bool, error) {
+	if r.i >= r.n {
+		return false, nil
+	}
+	values := []any{r.i, r.i * 10, "row"}
+	for i, column := range r.columns {
+		row[i] = values[column]
+	}
+	r.i++
+	return true, nil
+}
+
+func (r *rangeRows) Close() error {
+	*r.closed = true
+	return nil
+}
+
+func TestRegisterTableFunctionRequiresDriverConn(t *testing.T) {
+	err := RegisterTableFunction(struct{}{}, TableFunction{Name: "f"})
+	assert.Error(t, err)
+}
+
+func TestTableFunction(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	ctx := context.Background()
+	conn, err := sqlDB.Conn(ctx)
+	assert.NoError(t, err, "Error getting connection")
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	var scanned []int
+	closed := false
+	err = conn.Raw(func(driverConn any) error {
+		return RegisterTableFunction(driverConn, TableFunction{
+			Name:               "go_range",
+			Parameters:         []Type{TypeBigint},
+			NamedParameters:    map[string]Type{"offset": TypeBigint},
+			ProjectionPushdown: true,
+			Bind: func(args []any, named map[string]any) (*TableSource, error) {
+				n := args[0].(int64)
+				if n < 0 {
+					return nil, errors.New("negative row count")
+				}
+				if offset, ok := named["offset"]; ok {
+					n -= offset.(int64)
+				}
+				return &TableSource{
+					Columns: []TableColumn{
+						{Name: "i", Type: TypeBigint},
+						{Name: "tens", Type: TypeBigint},
+						{Name: "label", Type: TypeVarchar},
+					},
+					Cardinality:      int(n),
+					ExactCardinality: true,
+					Scan: func(columns []int) (TableRows, error) {
+						scanned = columns
+						return &rangeRows{n: n, columns: columns, closed: &closed}, nil
+					},
+				}, nil
+			},
+		})
+	})
+	assert.NoError(t, err, "Error registering function")
+
+	// Rows span several chunks
+	var count, sum int64
+	err = conn.QueryRowContext(ctx, "SELECT count(*), sum(tens) FROM go_range(5000)").Scan(&count, &sum)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5000), count)
+	assert.Equal(t, int64(10*4999*5000/2), sum)
+	assert.Equal(t, []int{1}, scanned, "only the used column is scanned")
+	assert.True(t, closed, "rows are closed at the end of the scan")
+
+	err = conn.QueryRowContext(ctx, "SELECT count(*) FROM go_range(10, offset := 4)").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), count)
+
+	// Joins with tables
+	_, err = conn.ExecContext(ctx, "CREATE TABLE names (i BIGINT, name VARCHAR)")
+	assert.NoError(t, err)
+	_, err = conn.ExecContext(ctx, "INSERT INTO names VALUES (1, 'one'), (3, 'three')")
+	assert.NoError(t, err)
+
+	rows, err := conn.QueryContext(ctx, "SELECT r.label, n.name FROM go_range(5) r JOIN names n USING (i) ORDER BY i")
+	assert.NoError(t, err)
+	var got []string
+	for rows.Next() {
+		var label, name string
+		assert.NoError(t, rows.Scan(&label, &name))
+		got = append(got, label+" "+name)
+	}
+	assert.NoError(t, rows.Err())
+	assert.NoError(t, rows.Close())
+	assert.Equal(t, []string{"row one", "row three"}, got)
+
+	// Errors of Bind abort the query
+	err = conn.QueryRowContext(ctx, "SELECT count(*) FROM go_range(-1)").Scan(&count)
+	assert.ErrorContains(t, err, "negative row count")
+}
@@ -0,0 +1,21 @@
+package pduckdb
+
+import (
+	"time"
+
+	"github.com/fpt/go-pduckdb/internal/convert"
+)
+
+// InfinityTime and NegativeInfinityTime are the values of DuckDB's 'infinity' and
+// '-infinity' dates and timestamps. They are read from DATE and TIMESTAMP columns,
+// including the TIMESTAMP variants, and bind as infinite values.
+// They sort after and before every finite time.
+var (
+	InfinityTime         = convert.InfinityTime
+	NegativeInfinityTime = convert.NegativeInfinityTime
+)
+
+// IsInfinity reports whether t is InfinityTime or NegativeInfinityTime
+func IsInfinity(t time.Time) bool {
+	return convert.Infinity(t) != 0
+}
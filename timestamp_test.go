@@ -0,0 +1,127 @@
+package pduckdb
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimestampVariants(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:?TimeZone=America/New_York")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec(`CREATE TABLE telemetry (
+		id INTEGER, s TIMESTAMP_S, ms TIMESTAMP_MS, ns TIMESTAMP_NS, tz TIMESTAMPTZ, ttz TIMETZ)`)
+	assert.NoError(t, err)
+
+	berlin := time.FixedZone("CEST", 2*3600)
+	ts := time.Date(2024, 7, 1, 12, 34, 56, 123_456_789, berlin)
+
+	// All variants are bound from time.Time without losing precision or the offset
+	_, err = sqlDB.Exec("INSERT INTO telemetry VALUES (1, ?, ?, ?, ?, ?)", ts, ts, ts, ts, ts)
+	assert.NoError(t, err)
+
+	var s, ms, ns, tz, ttz time.Time
+	err = sqlDB.QueryRow("SELECT s, ms, ns, tz, ttz FROM telemetry WHERE id = 1").Scan(&s, &ms, &ns, &tz, &ttz)
+	assert.NoError(t, err)
+	assert.True(t, ts.Truncate(time.Second).Equal(s), "TIMESTAMP_S = %v", s)
+	assert.True(t, ts.Truncate(time.Millisecond).Equal(ms), "TIMESTAMP_MS = %v", ms)
+	assert.True(t, ts.Equal(ns), "TIMESTAMP_NS = %v", ns)
+	assert.Equal(t, 123_456_789, ns.Nanosecond())
+
+	// TIMESTAMPTZ is returned in the session time zone
+	assert.True(t, ts.Truncate(time.Microsecond).Equal(tz), "TIMESTAMPTZ = %v", tz)
+	assert.Equal(t, "America/New_York", tz.Location().String())
+	assert.Equal(t, 6, tz.Hour())
+
+	// TIMETZ keeps its offset
+	_, offset := ttz.Zone()
+	assert.Equal(t, 2*3600, offset)
+	assert.Equal(t, 12, ttz.Hour())
+
+	var text string
+	err = sqlDB.QueryRow("SELECT ns::VARCHAR FROM telemetry WHERE id = 1").Scan(&text)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-07-01 10:34:56.123456789", text)
+
+	// Changing the session time zone applies to later results
+	conn, err := sqlDB.Conn(t.Context())
+	assert.NoError(t, err)
+	_, err = conn.ExecContext(t.Context(), "SET TimeZone = 'Europe/Paris'")
+	assert.NoError(t, err)
+	err = conn.QueryRowContext(t.Context(), "SELECT tz FROM telemetry WHERE id = 1").Scan(&tz)
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Paris", tz.Location().String())
+	assert.Equal(t, 12, tz.Hour())
+	assert.NoError(t, conn.Close())
+
+	// A configured location overrides the session time zone
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	connector, err := NewConnectorWithConfig(":memory:", Config{TimeZone: tokyo})
+	assert.NoError(t, err)
+	tokyoDB := sql.OpenDB(connector)
+	defer func() {
+		assert.NoError(t, tokyoDB.Close())
+	}()
+	err = tokyoDB.QueryRow("SELECT TIMESTAMPTZ '2024-07-01 00:00:00+00'").Scan(&tz)
+	assert.NoError(t, err)
+	assert.Equal(t, tokyo, tz.Location())
+	assert.Equal(t, 9, tz.Hour())
+}
+
+func TestInfinity(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec(`CREATE TABLE dim_customer (
+		id INTEGER, valid_from DATE, valid_to TIMESTAMP, expires TIMESTAMPTZ)`)
+	assert.NoError(t, err)
+	_, err = sqlDB.Exec(`INSERT INTO dim_customer VALUES
+		(1, '-infinity', 'infinity', 'infinity'),
+		(2, '2024-01-01', '2024-06-30 12:00:00', '2024-06-30 12:00:00+00')`)
+	assert.NoError(t, err)
+
+	// Infinite values are read as the sentinels, finite values as usual
+	var validFrom, validTo, expires time.Time
+	err = sqlDB.QueryRow("SELECT valid_from, valid_to, expires FROM dim_customer WHERE id = 1").Scan(&validFrom, &validTo, &expires)
+	assert.NoError(t, err)
+	assert.True(t, validFrom.Equal(NegativeInfinityTime), "valid_from = %v", validFrom)
+	assert.True(t, validTo.Equal(InfinityTime), "valid_to = %v", validTo)
+	assert.True(t, IsInfinity(expires))
+
+	err = sqlDB.QueryRow("SELECT valid_to FROM dim_customer WHERE id = 2").Scan(&validTo)
+	assert.NoError(t, err)
+	assert.False(t, IsInfinity(validTo))
+	assert.True(t, validTo.Before(InfinityTime))
+
+	// The sentinels bind as infinite values
+	_, err = sqlDB.Exec("INSERT INTO dim_customer VALUES (3, ?, ?, ?)", NegativeInfinityTime, InfinityTime, InfinityTime)
+	assert.NoError(t, err)
+
+	var text string
+	err = sqlDB.QueryRow("SELECT valid_from::VARCHAR || ' ' || valid_to::VARCHAR FROM dim_customer WHERE id = 3").Scan(&text)
+	assert.NoError(t, err)
+	assert.Equal(t, "-infinity infinity", text)
+
+	var current int
+	err = sqlDB.QueryRow("SELECT count(*) FROM dim_customer WHERE valid_to = ?", InfinityTime).Scan(&current)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, current)
+}
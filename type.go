@@ -0,0 +1,41 @@
+package pduckdb
+
+import (
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+)
+
+// Type is a DuckDB type, used to declare the parameters and results of Go functions
+type Type = duckdb.DuckDBType
+
+// DuckDB types that can be declared for Go functions
+const (
+	TypeInvalid     = duckdb.DuckDBTypeInvalid
+	TypeBoolean     = duckdb.DuckDBTypeBoolean
+	TypeTinyint     = duckdb.DuckDBTypeTinyint
+	TypeSmallint    = duckdb.DuckDBTypeSmallint
+	TypeInteger     = duckdb.DuckDBTypeInteger
+	TypeBigint      = duckdb.DuckDBTypeBigint
+	TypeUTinyint    = duckdb.DuckDBTypeUTinyint
+	TypeUSmallint   = duckdb.DuckDBTypeUSmallint
+	TypeUInteger    = duckdb.DuckDBTypeUInteger
+	TypeUBigint     = duckdb.DuckDBTypeUBigint
+	TypeFloat       = duckdb.DuckDBTypeFloat
+	TypeDouble      = duckdb.DuckDBTypeDouble
+	TypeTimestamp   = duckdb.DuckDBTypeTimestamp
+	TypeDate        = duckdb.DuckDBTypeDate
+	TypeTime        = duckdb.DuckDBTypeTime
+	TypeInterval    = duckdb.DuckDBTypeInterval
+	TypeHugeint     = duckdb.DuckDBTypeHugeint
+	TypeUHugeint    = duckdb.DuckDBTypeUHugeint
+	TypeVarchar     = duckdb.DuckDBTypeVarchar
+	TypeBlob        = duckdb.DuckDBTypeBlob
+	TypeTimestampS  = duckdb.DuckDBTypeTimestampS
+	TypeTimestampMS = duckdb.DuckDBTypeTimestampMS
+	TypeTimestampNS = duckdb.DuckDBTypeTimestampNS
+	TypeUUID        = duckdb.DuckDBTypeUUID
+	TypeBit         = duckdb.DuckDBTypeBit
+	TypeTimeTZ      = duckdb.DuckDBTypeTimeTZ
+	TypeTimestampTZ = duckdb.DuckDBTypeTimestampTZ
+	// TypeAny accepts arguments of every type
+	TypeAny = duckdb.DuckDBTypeAny
+)
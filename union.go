@@ -0,0 +1,84 @@
+package pduckdb
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+)
+
+// Union is a value of a DuckDB UNION: the tag of its member and the member's value.
+// UNION columns are read as Union, and Union arguments bind to UNION parameters.
+// The member value is bound with the type of the tagged member, so members
+// of a UNION parameter must have distinct types.
+type Union = duckdb.Union
+
+// UnionMembers returns the member tags of a UNION column, in the order they were declared.
+// It reports false for columns of other types.
+func UnionMembers(ct *sql.ColumnType) ([]string, bool) {
+	return parseUnionType(ct.DatabaseTypeName())
+}
+
+// parseUnionType parses the member tags of a UNION type name like "UNION(num INTEGER, str VARCHAR)"
+func parseUnionType(typeName string) ([]string, bool) {
+	list, ok := strings.CutPrefix(typeName, "UNION(")
+	if !ok {
+		return nil, false
+	}
+	list, ok = strings.CutSuffix(list, ")")
+	if !ok {
+		return nil, false
+	}
+
+	tags := []string{}
+	for list != "" {
+		var tag string
+		if rest, ok := strings.CutPrefix(list, `"`); ok {
+			// Quotes inside a quoted tag are doubled
+			var sb strings.Builder
+			for {
+				end := strings.IndexByte(rest, '"')
+				if end < 0 {
+					return nil, false
+				}
+				sb.WriteString(rest[:end])
+				rest = rest[end+1:]
+				if !strings.HasPrefix(rest, `"`) {
+					break
+				}
+				sb.WriteByte('"')
+				rest = rest[1:]
+			}
+			tag, list = sb.String(), rest
+		} else {
+			end := strings.IndexByte(list, ' ')
+			if end < 0 {
+				return nil, false
+			}
+			tag, list = list[:end], list[end:]
+		}
+		tags = append(tags, tag)
+
+		// Skip the member type, which may contain nested parentheses and quotes
+		var quote byte
+		depth, end := 0, len(list)
+		for i := 0; i < len(list) && end == len(list); i++ {
+			switch c := list[i]; {
+			case quote != 0:
+				if c == quote {
+					quote = 0
+				}
+			case c == '"' || c == '\'':
+				quote = c
+			case c == '(':
+				depth++
+			case c == ')':
+				depth--
+			case c == ',' && depth == 0:
+				end = i
+			}
+		}
+		list = strings.TrimPrefix(list[end:], ", ")
+	}
+	return tags, true
+}
@@ -0,0 +1,93 @@
+package pduckdb
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUnionType(t *testing.T) {
+	tests := []struct {
+		typeName string
+		expected []string
+		ok       bool
+	}{
+		{typeName: "UNION(num INTEGER, str VARCHAR)", expected: []string{"num", "str"}, ok: true},
+		{typeName: `UNION("Click" STRUCT(x INTEGER, y INTEGER), "a""b" ENUM('x, y', 'z'), n DECIMAL(18,3))`, expected: []string{"Click", `a"b`, "n"}, ok: true},
+		{typeName: "UNION(only BIGINT[])", expected: []string{"only"}, ok: true},
+		{typeName: "STRUCT(a INTEGER)"},
+		{typeName: "UNION(broken"},
+	}
+
+	for _, tt := range tests {
+		tags, ok := parseUnionType(tt.typeName)
+		assert.Equal(t, tt.ok, ok, tt.typeName)
+		if tt.ok {
+			assert.Equal(t, tt.expected, tags, tt.typeName)
+		}
+	}
+}
+
+func TestUnion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec(`CREATE TABLE events (id INTEGER,
+		payload UNION(click STRUCT(x INTEGER, y INTEGER), message VARCHAR, code BIGINT))`)
+	assert.NoError(t, err)
+	_, err = sqlDB.Exec(`INSERT INTO events VALUES
+		(1, union_value(click := {'x': 1, 'y': 2})), (2, 'hello'), (3, NULL)`)
+	assert.NoError(t, err)
+
+	// UNION values are read with the tag of their member
+	var u Union
+	err = sqlDB.QueryRow("SELECT payload FROM events WHERE id = 1").Scan(&u)
+	assert.NoError(t, err)
+	assert.Equal(t, Union{Tag: "click", Value: map[string]any{"x": int32(1), "y": int32(2)}}, u)
+
+	err = sqlDB.QueryRow("SELECT payload FROM events WHERE id = 2").Scan(&u)
+	assert.NoError(t, err)
+	assert.Equal(t, Union{Tag: "message", Value: "hello"}, u)
+
+	var null any
+	err = sqlDB.QueryRow("SELECT payload FROM events WHERE id = 3").Scan(&null)
+	assert.NoError(t, err)
+	assert.Nil(t, null)
+
+	// Unions are bound as the tagged member
+	_, err = sqlDB.Exec("INSERT INTO events VALUES (4, ?), (5, ?)",
+		Union{Tag: "code", Value: 404}, &Union{Tag: "message", Value: "bye"})
+	assert.NoError(t, err)
+
+	var tag string
+	var code int64
+	err = sqlDB.QueryRow("SELECT union_tag(payload), payload.code FROM events WHERE id = 4").Scan(&tag, &code)
+	assert.NoError(t, err)
+	assert.Equal(t, "code", tag)
+	assert.Equal(t, int64(404), code)
+
+	_, err = sqlDB.Exec("INSERT INTO events VALUES (6, ?)", Union{Tag: "unknown", Value: 1})
+	assert.ErrorContains(t, err, `invalid UNION tag "unknown", expected one of click, message, code`)
+
+	// The members are part of the column type
+	rows, err := sqlDB.Query("SELECT payload FROM events")
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, rows.Close())
+	}()
+	types, err := rows.ColumnTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, reflect.TypeOf(Union{}), types[0].ScanType())
+	members, ok := UnionMembers(types[0])
+	assert.True(t, ok)
+	assert.Equal(t, []string{"click", "message", "code"}, members)
+}
@@ -0,0 +1,15 @@
+package pduckdb
+
+import (
+	"github.com/fpt/go-pduckdb/internal/duckdb"
+)
+
+// UUID is a DuckDB UUID. UUID columns scan into a UUID, which implements
+// sql.Scanner, driver.Valuer and encoding.TextMarshaler. UUID parameters
+// accept a UUID, a [16]byte and the text representation of a UUID.
+type UUID = duckdb.UUID
+
+// ParseUUID parses a UUID like "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+func ParseUUID(s string) (UUID, error) {
+	return duckdb.ParseUUID(s)
+}
@@ -0,0 +1,80 @@
+package pduckdb
+
+import (
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	sqlDB, err := sql.Open("duckdb", ":memory:")
+	assert.NoError(t, err, "Error opening database")
+	defer func() {
+		assert.NoError(t, sqlDB.Close())
+	}()
+
+	_, err = sqlDB.Exec("CREATE TABLE users (id UUID PRIMARY KEY, name VARCHAR)")
+	assert.NoError(t, err)
+
+	alice, err := ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	assert.NoError(t, err)
+	// The top bit is flipped in DuckDB's storage, so UUIDs on both sides of it must round-trip
+	bob, err := ParseUUID("00000000-0000-0000-0000-000000000001")
+	assert.NoError(t, err)
+	carol, err := ParseUUID("ffffffff-ffff-ffff-ffff-ffffffffffff")
+	assert.NoError(t, err)
+
+	// UUIDs are bound from UUID, [16]byte and text
+	_, err = sqlDB.Exec("INSERT INTO users VALUES (?, 'alice'), (?, 'bob'), (?, 'carol')",
+		alice, [16]byte(bob), carol.String())
+	assert.NoError(t, err)
+
+	var id UUID
+	err = sqlDB.QueryRow("SELECT id FROM users WHERE name = 'alice'").Scan(&id)
+	assert.NoError(t, err)
+	assert.Equal(t, alice, id)
+
+	err = sqlDB.QueryRow("SELECT id FROM users WHERE name = 'carol'").Scan(&id)
+	assert.NoError(t, err)
+	assert.Equal(t, carol, id)
+
+	var name string
+	err = sqlDB.QueryRow("SELECT name FROM users WHERE id = ?", [16]byte(bob)).Scan(&name)
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", name)
+
+	// UUIDs sort like their text representation
+	rows, err := sqlDB.Query("SELECT id FROM users ORDER BY id")
+	assert.NoError(t, err)
+	var sorted []UUID
+	for rows.Next() {
+		assert.NoError(t, rows.Scan(&id))
+		sorted = append(sorted, id)
+	}
+	assert.NoError(t, rows.Err())
+	assert.NoError(t, rows.Close())
+	assert.Equal(t, []UUID{bob, alice, carol}, sorted)
+
+	var text string
+	err = sqlDB.QueryRow("SELECT id::VARCHAR FROM users WHERE name = 'alice'").Scan(&text)
+	assert.NoError(t, err)
+	assert.Equal(t, alice.String(), text)
+
+	encoded, err := json.Marshal(map[string]UUID{"id": alice})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id": "6ba7b810-9dad-11d1-80b4-00c04fd430c8"}`, string(encoded))
+
+	rows, err = sqlDB.Query("SELECT id FROM users")
+	assert.NoError(t, err)
+	types, err := rows.ColumnTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, reflect.TypeOf(UUID{}), types[0].ScanType())
+	assert.NoError(t, rows.Close())
+}